@@ -0,0 +1,69 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const alertsPageSize = 100
+
+// TestAlert is an alert raised by a test, as listed by the alerts endpoint
+type TestAlert struct {
+	Id           json.Number   `json:"id"`
+	Test         GenericIdName `json:"test"`
+	Node         GenericIdName `json:"node"`
+	AlertType    GenericIdName `json:"alertType"`
+	AlertSubType GenericIdName `json:"alertSubType"`
+	Level        GenericIdName `json:"level"`
+	ReportTime   string        `json:"reportTime"`
+}
+
+// getTestAlerts pages through all the alerts raised, without a time limit, optionally only for the given tests
+func getTestAlerts(apiToken string, testIds []int) ([]TestAlert, string, error) {
+
+	type Data struct {
+		Alerts []TestAlert `json:"alerts"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	query := url.Values{}
+	if len(testIds) > 0 {
+		testIdStrings := make([]string, len(testIds))
+		for i, testId := range testIds {
+			testIdStrings[i] = strconv.Itoa(testId)
+		}
+		query.Set("testIds", strings.Join(testIdStrings, ","))
+	}
+	query.Set("pageSize", strconv.Itoa(alertsPageSize))
+
+	var alerts []TestAlert
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		query.Set("pageNumber", strconv.Itoa(pageNumber))
+		getURL := catchpointTestURI + "/alerts?" + query.Encode()
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		alerts = append(alerts, response.ResponseData.Alerts...)
+		if len(response.ResponseData.Alerts) < alertsPageSize {
+			break
+		}
+	}
+
+	return alerts, responseStatus, nil
+}
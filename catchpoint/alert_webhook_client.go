@@ -0,0 +1,55 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const alertWebhooksPageSize = 100
+
+// AlertWebhookDetail is an alert webhook as listed by the alert webhooks endpoint, as opposed to the
+// AlertWebhook reference embedded in a test's notification group
+type AlertWebhookDetail struct {
+	Id         int           `json:"id"`
+	Name       string        `json:"name"`
+	Url        string        `json:"url"`
+	DivisionId int           `json:"divisionId"`
+	Status     GenericIdName `json:"status"`
+}
+
+// getAlertWebhooks pages through every alert webhook visible to the API token
+func getAlertWebhooks(apiToken string) ([]AlertWebhookDetail, string, error) {
+
+	type Data struct {
+		AlertWebhooks []AlertWebhookDetail `json:"alertWebhooks"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var alertWebhooks []AlertWebhookDetail
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointApiURI + "/alertwebhooks?pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(alertWebhooksPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		alertWebhooks = append(alertWebhooks, response.ResponseData.AlertWebhooks...)
+		if len(response.ResponseData.AlertWebhooks) < alertWebhooksPageSize {
+			break
+		}
+	}
+
+	return alertWebhooks, responseStatus, nil
+}
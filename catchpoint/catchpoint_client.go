@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -161,6 +162,9 @@ type AdvancedSetting struct {
 	FailureHopCount           int                      `json:"failureHopCount,omitempty"`
 	PingCount                 int                      `json:"pingCount,omitempty"`
 	EdnsSubnet                string                   `json:"ednsSubnet,omitempty"`
+	Bitrate                   int                      `json:"bitrate,omitempty"`
+	PlaybackDuration          int                      `json:"playbackDuration,omitempty"`
+	StartupTimeThreshold      int                      `json:"startupTimeThreshold,omitempty"`
 	AdditionalMonitor         *GenericIdNameOmitEmpty  `json:"additionalMonitor,omitempty"`
 	TestBandwidthThrottling   *GenericIdNameOmitEmpty  `json:"testBandwidthThrottling,omitempty"`
 	Id                        int                      `json:"id"`
@@ -179,6 +183,37 @@ type TestRequestDataStruct struct {
 	Monitor               GenericIdNameOmitEmpty `json:"monitor,omitempty"`
 }
 
+// WebSocketScript is the request data of a websocket test, sent as the script of its TestRequestDataStruct
+type WebSocketScript struct {
+	SubProtocols []string           `json:"subProtocols,omitempty"`
+	Messages     []WebSocketMessage `json:"messages"`
+}
+
+type WebSocketMessage struct {
+	Send   string `json:"send,omitempty"`
+	Expect string `json:"expect,omitempty"`
+}
+
+// CustomScript is the request data of a custom test, sent as the script of its TestRequestDataStruct
+type CustomScript struct {
+	Definition string         `json:"definition"`
+	Metrics    []CustomMetric `json:"metrics"`
+}
+
+type CustomMetric struct {
+	Name     string `json:"name"`
+	DataType string `json:"dataType"`
+	Unit     string `json:"unit,omitempty"`
+	Required bool   `json:"required"`
+}
+
+// BgpSettings holds the prefixes a bgp test monitors and the ASNs expected to originate them and to neighbor the origin
+type BgpSettings struct {
+	Prefixes          []string `json:"prefixes"`
+	OriginAsns        []int    `json:"originAsns"`
+	UpstreamNeighbors []int    `json:"upstreamNeighbors"`
+}
+
 type Test struct {
 	Id                           int                         `json:"id"`
 	DivisionId                   int                         `json:"divisionId"`
@@ -201,6 +236,16 @@ type Test struct {
 	Monitor                      GenericIdName               `json:"monitor"`
 	DnsServer                    string                      `json:"dnsServer,omitempty"`
 	DnsQueryType                 *GenericIdNameOmitEmpty     `json:"dnsQueryType,omitempty"`
+	EncryptionType               *GenericIdName              `json:"encryptionType,omitempty"`
+	FtpOperationType             *GenericIdName              `json:"ftpOperationType,omitempty"`
+	ExpectedFileSize             int                         `json:"expectedFileSize,omitempty"`
+	Payload                      string                      `json:"payload,omitempty"`
+	ExpectedResponse             string                      `json:"expectedResponse,omitempty"`
+	NtpOffsetThreshold           int                         `json:"ntpOffsetThreshold,omitempty"`
+	MqttTopic                    string                      `json:"mqttTopic,omitempty"`
+	MqttQos                      int                         `json:"mqttQos,omitempty"`
+	Mailbox                      string                      `json:"mailbox,omitempty"`
+	BgpSettings                  *BgpSettings                `json:"bgpSettings,omitempty"`
 	UserAgentType                *GenericIdNameOmitEmpty     `json:"userAgentTypeId,omitempty"`
 	ChromeMonitorVersion         *ChromeMonitorVersionStruct `json:"chromeMonitorVersion,omitempty"`
 	TestRequestData              *TestRequestDataStruct      `json:"testRequestData,omitempty"`
@@ -289,12 +334,52 @@ func createJson(config TestConfig) string {
 		t.DnsServer = config.DnsServer
 	}
 
+	if testType.Id == int(TestType(Smtp)) ||
+		testType.Id == int(TestType(Imap)) ||
+		testType.Id == int(TestType(Pop)) {
+		t.EncryptionType = &GenericIdName{Id: config.EncryptionType.Id, Name: config.EncryptionType.Name}
+	}
+
+	if testType.Id == int(TestType(Ftp)) {
+		t.FtpOperationType = &GenericIdName{Id: config.FtpOperationType.Id, Name: config.FtpOperationType.Name}
+		t.ExpectedFileSize = config.ExpectedFileSize
+	}
+
+	if testType.Id == int(TestType(Tcp)) ||
+		testType.Id == int(TestType(Udp)) ||
+		testType.Id == int(TestType(Mqtt)) ||
+		testType.Id == int(TestType(Imap)) ||
+		testType.Id == int(TestType(Pop)) {
+		t.Payload = config.Payload
+		t.ExpectedResponse = config.ExpectedResponse
+	}
+
+	if testType.Id == int(TestType(Imap)) {
+		t.Mailbox = config.Mailbox
+	}
+
+	if testType.Id == int(TestType(Mqtt)) {
+		t.MqttTopic = config.MqttTopic
+		t.MqttQos = config.MqttQos
+	}
+
+	if testType.Id == int(TestType(Ntp)) {
+		t.NtpOffsetThreshold = config.NtpOffsetThreshold
+	}
+
+	if testType.Id == int(TestType(Bgp)) {
+		bgpSettings := setTestBgpSettings(&config)
+		t.BgpSettings = &bgpSettings
+	}
+
 	requestData := setTestRequestData(&config)
 
 	if testType.Id == int(TestType(Api)) ||
 		testType.Id == int(TestType(Transaction)) ||
 		testType.Id == int(TestType(Playwright)) ||
-		testType.Id == int(TestType(Puppeteer)) {
+		testType.Id == int(TestType(Puppeteer)) ||
+		testType.Id == int(TestType(WebSocket)) ||
+		testType.Id == int(TestType(Custom)) {
 		t.TestRequestData = &requestData
 	}
 
@@ -303,6 +388,15 @@ func createJson(config TestConfig) string {
 }
 
 func getTest(apiToken string, testId string) (*Test, string, error) {
+	return getTestWithProperties(apiToken, testId, false)
+}
+
+// getEffectiveTest reads a test with the settings it inherits from its folder, product and division filled in
+func getEffectiveTest(apiToken string, testId string) (*Test, string, error) {
+	return getTestWithProperties(apiToken, testId, true)
+}
+
+func getTestWithProperties(apiToken string, testId string, showInheritedProperties bool) (*Test, string, error) {
 
 	type Data struct {
 		Tests []Test `json:"tests"`
@@ -324,7 +418,7 @@ func getTest(apiToken string, testId string) (*Test, string, error) {
 
 	var response Response
 	var responseStatus = ""
-	getURL := catchpointTestURI + "/" + testId + "?showInheritedProperties=false"
+	getURL := catchpointTestURI + "/" + testId + "?showInheritedProperties=" + strconv.FormatBool(showInheritedProperties)
 	req, _ := http.NewRequest("", getURL, nil)
 	req.Header.Set("Authorization", "Bearer "+apiToken)
 	req.Header.Set("Content-Type", "application/json")
@@ -349,6 +443,45 @@ func getTest(apiToken string, testId string) (*Test, string, error) {
 	return &test, responseStatus, nil
 }
 
+const testsPageSize = 100
+
+// getTests pages through every test visible to the API token. Filtering is left to the caller
+func getTests(apiToken string) ([]Test, string, error) {
+
+	type Data struct {
+		Tests []Test `json:"tests"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var tests []Test
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointTestURI + "?pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(testsPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		tests = append(tests, response.ResponseData.Tests...)
+		if len(response.ResponseData.Tests) < testsPageSize {
+			break
+		}
+	}
+
+	return tests, responseStatus, nil
+}
+
 func createTest(apiToken string, jsonPayload string) (string, string, string, error) {
 
 	type Data struct {
@@ -449,6 +582,18 @@ func setTestRequestData(config *TestConfig) TestRequestDataStruct {
 	return requestData
 }
 
+func setTestBgpSettings(config *TestConfig) BgpSettings {
+	bgpSettings := BgpSettings{Prefixes: config.BgpPrefixes, OriginAsns: config.BgpOriginAsns, UpstreamNeighbors: config.BgpUpstreamNeighbors}
+	if bgpSettings.OriginAsns == nil {
+		bgpSettings.OriginAsns = []int{}
+	}
+	if bgpSettings.UpstreamNeighbors == nil {
+		bgpSettings.UpstreamNeighbors = []int{}
+	}
+
+	return bgpSettings
+}
+
 func setTestThresholds(config *TestConfig) Thresholds {
 	thresholds := Thresholds{TestTimeApdexThresholdWarning: config.TestTimeThresholdWarning, TestTimeApdexThresholdCritical: config.TestTimeThresholdCritical, AvailabilityApdexThresholdWarning: config.AvailabilityThresholdWarning, AvailabilityApdexThresholdCritical: config.AvailabilityThresholdCritical}
 
@@ -641,7 +786,7 @@ func setTestAdvancedSettings(config *TestConfig) AdvancedSetting {
 	}
 
 	advancedSettings := AdvancedSetting{}
-	advancedSettings = AdvancedSetting{AdvancedSettingType: advancedSettingType, AppliedTestFlags: appliedTestFlags, MaxStepRuntimeSecOverride: config.MaxStepRuntimeSecOverride, WaitForNoActivity: config.WaitForNoActivityOnDocComplete, ViewportHeight: config.ViewportHeight, ViewportWidth: config.ViewportWidth, FailureHopCount: config.TracerouteFailureHopCount, PingCount: config.TraceroutePingCount, EdnsSubnet: config.EdnsSubnet, Id: advancedSettingId}
+	advancedSettings = AdvancedSetting{AdvancedSettingType: advancedSettingType, AppliedTestFlags: appliedTestFlags, MaxStepRuntimeSecOverride: config.MaxStepRuntimeSecOverride, WaitForNoActivity: config.WaitForNoActivityOnDocComplete, ViewportHeight: config.ViewportHeight, ViewportWidth: config.ViewportWidth, FailureHopCount: config.TracerouteFailureHopCount, PingCount: config.TraceroutePingCount, EdnsSubnet: config.EdnsSubnet, Bitrate: config.StreamingBitrate, PlaybackDuration: config.StreamingPlaybackDuration, StartupTimeThreshold: config.StreamingStartupTimeThreshold, Id: advancedSettingId}
 	additionalMonitor := GenericIdNameOmitEmpty{Id: config.AdditionalMonitorType.Id, Name: config.AdditionalMonitorType.Name}
 	if additionalMonitor != (GenericIdNameOmitEmpty{}) {
 		advancedSettings.AdditionalMonitor = &additionalMonitor
@@ -701,6 +846,11 @@ func createJsonPatchDocument(config TestConfigUpdate, path string, isTestMetaDat
 		Path                 string                `json:"path"`
 		Op                   string                `json:"op"`
 	}
+	type JsonPatchBgp struct {
+		BgpSettingsValue BgpSettings `json:"value"`
+		Path             string      `json:"path"`
+		Op               string      `json:"op"`
+	}
 
 	var jsonPatchDoc = []byte{}
 
@@ -737,6 +887,14 @@ func createJsonPatchDocument(config TestConfigUpdate, path string, isTestMetaDat
 		}
 		jsonPatchDoc, _ = json.Marshal(jsonPatchObject)
 	}
+	if config.SectionToUpdate == "/bgpSettings" {
+		jsonPatchObject := JsonPatchBgp{
+			BgpSettingsValue: config.UpdatedBgpSettingsSection,
+			Path:             path,
+			Op:               "replace",
+		}
+		jsonPatchDoc, _ = json.Marshal(jsonPatchObject)
+	}
 	if config.SectionToUpdate == "/advancedSettings" {
 		jsonPatchObject := JsonPatchAdvanced{
 			AdvancedSettingValue: config.UpdatedAdvancedSettingsSection,
@@ -826,3 +984,93 @@ func updateTest(apiToken string, testId string, jsonPayload string) (string, str
 
 	return string(body), responseStatus, completed, nil
 }
+
+func sendRequest(apiToken string, method string, requestURL string, payload []byte) ([]byte, string, error) {
+
+	// Consume a token before proceeding
+	<-tokens
+
+	var responseStatus = ""
+	req, _ := http.NewRequest(method, requestURL, bytes.NewBuffer(payload))
+	req.Header.Set("Authorization", "Bearer "+apiToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("cp-integration", "1")
+
+	client := &http.Client{}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, responseStatus, err
+	}
+	defer resp.Body.Close()
+
+	responseStatus = strings.ToLower(string(resp.Status))
+	body, _ := ioutil.ReadAll(resp.Body)
+
+	return body, responseStatus, nil
+}
+
+type ApiError struct {
+	Id      json.Number `json:"id"`
+	Message string      `json:"message"`
+}
+
+func createEntity(apiToken string, requestURL string, jsonPayload string) (string, string, string, error) {
+
+	type Data struct {
+		Id json.Number `json:"id"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	body, responseStatus, err := sendRequest(apiToken, "POST", requestURL, []byte(jsonPayload))
+	if err != nil {
+		return "", responseStatus, "", err
+	}
+	json.Unmarshal(body, &response)
+
+	return string(body), responseStatus, string(response.ResponseData.Id), nil
+}
+
+func updateEntity(apiToken string, requestURL string, jsonPayload string) (string, string, bool, error) {
+
+	type Response struct {
+		Messages  []string   `json:"messages"`
+		Errors    []ApiError `json:"errors"`
+		Completed bool       `json:"completed"`
+		TraceId   string     `json:"traceId"`
+	}
+
+	var response Response
+	body, responseStatus, err := sendRequest(apiToken, "PUT", requestURL, []byte(jsonPayload))
+	if err != nil {
+		return "", responseStatus, false, err
+	}
+	json.Unmarshal(body, &response)
+
+	return string(body), responseStatus, response.Completed, nil
+}
+
+func deleteEntity(apiToken string, requestURL string) (string, string, bool, error) {
+
+	type Response struct {
+		Messages  []string   `json:"messages"`
+		Errors    []ApiError `json:"errors"`
+		Completed bool       `json:"completed"`
+		TraceId   string     `json:"traceId"`
+	}
+
+	var response Response
+	body, responseStatus, err := sendRequest(apiToken, "DELETE", requestURL, nil)
+	if err != nil {
+		return "", responseStatus, false, err
+	}
+	json.Unmarshal(body, &response)
+
+	return string(body), responseStatus, response.Completed, nil
+}
@@ -0,0 +1,106 @@
+package catchpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type ChromeVersion struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+func getChromeVersions(apiToken string) ([]ChromeVersion, string, error) {
+
+	type Data struct {
+		ChromeVersions []ChromeVersion `json:"chromeVersions"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointTestURI + "/chromeversions"
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return nil, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+
+	return response.ResponseData.ChromeVersions, responseStatus, nil
+}
+
+// getChromeVersionCatalogue returns the Chrome versions available to the account. They are fetched
+// once per provider instance. If the API cannot be reached the built-in list is returned along with the error
+func getChromeVersionCatalogue(config *Config) ([]ChromeVersion, error) {
+	config.chromeVersionsMutex.Lock()
+	defer config.chromeVersionsMutex.Unlock()
+
+	if config.chromeVersions != nil {
+		return config.chromeVersions, nil
+	}
+	chromeVersions, respStatus, err := getChromeVersions(config.ApiToken)
+	if err == nil && respStatus != "200 ok" {
+		err = errors.New(respStatus)
+	}
+	if err == nil && len(chromeVersions) == 0 {
+		err = errors.New("no chrome versions returned by the API")
+	}
+	if err != nil {
+		return defaultChromeVersions, err
+	}
+	config.chromeVersions = chromeVersions
+
+	return chromeVersions, nil
+}
+
+// getTestChromeVersions is used by the test resources, which fall back to the built-in list rather than failing
+func getTestChromeVersions(m interface{}) []ChromeVersion {
+	chromeVersions, err := getChromeVersionCatalogue(m.(*Config))
+	if err != nil {
+		log.Printf("[WARN] Could not fetch the Chrome version catalogue, using the built-in list: %v", err)
+	}
+	return chromeVersions
+}
+
+// getTestChromeVersionName returns the chrome_version value of a test: stable, preview or the specific version
+func getTestChromeVersionName(test *Test, m interface{}) string {
+	if test.ChromeMonitorVersion == nil {
+		return ""
+	}
+	if test.ChromeMonitorVersion.ApplicationVersionType.Id == 3 {
+		return getChromeApplicationVersionName(getTestChromeVersions(m), test.ChromeMonitorVersion.ApplicationVersionId)
+	}
+	return strings.ToLower(test.ChromeMonitorVersion.ApplicationVersionType.Name)
+}
+
+// validateChromeVersionDiff checks chrome_version against the Chrome version catalogue at plan time
+func validateChromeVersionDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if m == nil || !d.HasChange("chrome_version") || !d.NewValueKnown("chrome_version") {
+		return nil
+	}
+	chrome_version := d.Get("chrome_version").(string)
+	chrome_version_id, _ := getChromeVersionId(chrome_version)
+	if chrome_version_id != 3 {
+		return nil
+	}
+	chromeVersions := getTestChromeVersions(m)
+	application_version_id, _ := getChromeApplicationVersionId(chromeVersions, chrome_version)
+	if application_version_id == 0 {
+		versionNames := make([]string, len(chromeVersions))
+		for i, chromeVersion := range chromeVersions {
+			versionNames[i] = chromeVersion.Name
+		}
+		return errors.New("invalid chrome_version " + chrome_version + " provided. acceptable values are preview, stable, " + strings.Join(versionNames, ", "))
+	}
+	return nil
+}
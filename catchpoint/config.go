@@ -4,20 +4,28 @@ const (
 	catchpointTestURIProd  = "https://io.catchpoint.com/api/v2/tests"
 	catchpointTestURIStage = "https://iostage.catchpoint.com/api/v2/tests"
 	catchpointTestURIQa    = "https://ioqa.catchpoint.com/api/v2/tests"
+	catchpointApiURIProd   = "https://io.catchpoint.com/api/v2"
+	catchpointApiURIStage  = "https://iostage.catchpoint.com/api/v2"
+	catchpointApiURIQa     = "https://ioqa.catchpoint.com/api/v2"
 )
 
 var catchpointTestURI = "https://io.catchpoint.com/api/v2/tests"
+var catchpointApiURI = "https://io.catchpoint.com/api/v2"
 
 func setTestUriByEnv(environment string) {
 
 	switch environment {
 	case "prod", "":
 		catchpointTestURI = catchpointTestURIProd
+		catchpointApiURI = catchpointApiURIProd
 	case "stage":
 		catchpointTestURI = catchpointTestURIStage
+		catchpointApiURI = catchpointApiURIStage
 	case "qa":
 		catchpointTestURI = catchpointTestURIQa
+		catchpointApiURI = catchpointApiURIQa
 	default:
 		catchpointTestURI = catchpointTestURIProd
+		catchpointApiURI = catchpointApiURIProd
 	}
 }
@@ -0,0 +1,52 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const contactGroupsPageSize = 100
+
+type ContactGroup struct {
+	Id          int    `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	DivisionId  int    `json:"divisionId"`
+}
+
+// getContactGroups pages through every contact group visible to the API token
+func getContactGroups(apiToken string) ([]ContactGroup, string, error) {
+
+	type Data struct {
+		ContactGroups []ContactGroup `json:"contactGroups"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var contactGroups []ContactGroup
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointApiURI + "/contactgroups?pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(contactGroupsPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		contactGroups = append(contactGroups, response.ResponseData.ContactGroups...)
+		if len(response.ResponseData.ContactGroups) < contactGroupsPageSize {
+			break
+		}
+	}
+
+	return contactGroups, responseStatus, nil
+}
@@ -0,0 +1,126 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlertWebhooks() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlertWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Names of the alert webhooks to resolve. The read fails when a name does not match exactly one alert webhook. Leave empty to return every alert webhook",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"division_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Optional. Only consider alert webhooks of this division",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the alert webhooks, in the order of names, or ascending when names is empty. Can be passed straight to notification_group.alert_webhook_ids",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"alert_webhooks": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The alert webhooks, in the same order as ids",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"division_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertWebhooksRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching alert webhooks")
+	alertWebhooks, respStatus, err := getAlertWebhooks(api_token)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching alert webhooks")
+		return errors.New(respStatus)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	division_id := d.Get("division_id").(int)
+	alertWebhooksById := map[int]AlertWebhookDetail{}
+	alertWebhookIds := map[string][]int{}
+	var ids []int
+	for _, alertWebhook := range alertWebhooks {
+		if division_id != 0 && alertWebhook.DivisionId != division_id {
+			continue
+		}
+		alertWebhooksById[alertWebhook.Id] = alertWebhook
+		alertWebhookIds[alertWebhook.Name] = append(alertWebhookIds[alertWebhook.Name], alertWebhook.Id)
+		ids = append(ids, alertWebhook.Id)
+	}
+	sort.Ints(ids)
+
+	if names := d.Get("names").([]interface{}); len(names) > 0 {
+		ids, err = resolveNamesToIds("alert webhook", names, alertWebhookIds)
+		if err != nil {
+			return err
+		}
+	}
+
+	idStrings := make([]string, len(ids))
+	alertWebhookMaps := make([]interface{}, len(ids))
+	for i, id := range ids {
+		alertWebhook := alertWebhooksById[id]
+		idStrings[i] = strconv.Itoa(id)
+		alertWebhookMaps[i] = map[string]interface{}{
+			"id":          alertWebhook.Id,
+			"name":        alertWebhook.Name,
+			"url":         alertWebhook.Url,
+			"division_id": alertWebhook.DivisionId,
+			"status":      strings.ToLower(alertWebhook.Status.Name),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(idStrings, ","))))
+	d.Set("ids", ids)
+	d.Set("alert_webhooks", alertWebhookMaps)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlerts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAlertsRead,
+
+		Schema: map[string]*schema.Schema{
+			"test_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return alerts of these tests",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"label_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. Only return alerts of tests carrying a label with this key",
+			},
+			"label_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return alerts of tests whose label_key label has this value",
+				RequiredWith: []string{"label_key"},
+			},
+			"severity": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return alerts with this severity: 'warning' or 'critical'",
+				ValidateFunc: validation.StringInSlice([]string{"warning", "critical"}, false),
+			},
+			"alerts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The active alerts, ordered by test id and node id. An alert is active when the latest alert of its test, node and alert sub type is a warning or critical one",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"test_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"node_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"node_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alert_sub_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"severity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"report_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"test_ids_alerting": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the tests with at least one active alert, in ascending order",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlertsRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	var test_ids []int
+	for _, test_id := range d.Get("test_ids").([]interface{}) {
+		test_ids = append(test_ids, test_id.(int))
+	}
+
+	// Alerts do not carry the labels of their test, so resolve the label filter to test ids first
+	label_key := d.Get("label_key").(string)
+	label_value := d.Get("label_value").(string)
+	if label_key != "" {
+		log.Printf("[DEBUG] Fetching tests labelled " + label_key)
+		tests, respStatus, err := getTests(api_token)
+		if err != nil {
+			return err
+		}
+		if respStatus != "200 ok" {
+			log.Printf("[ERROR] Error while listing tests")
+			return errors.New(respStatus)
+		}
+		requestedTestIds := map[int]bool{}
+		for _, test_id := range test_ids {
+			requestedTestIds[test_id] = true
+		}
+		labelledTestIds := []int{}
+		for _, test := range tests {
+			if len(requestedTestIds) > 0 && !requestedTestIds[test.Id] {
+				continue
+			}
+			if hasTestLabel(test.Labels, label_key, label_value) {
+				labelledTestIds = append(labelledTestIds, test.Id)
+			}
+		}
+		if len(labelledTestIds) == 0 {
+			return setAlertsData(d, m.(*Config), nil)
+		}
+		test_ids = labelledTestIds
+	}
+
+	// An alert raised long ago stays active until a later alert of the same test, node and alert sub type
+	// clears it, so the whole alert history is read rather than a recent window
+	log.Printf("[DEBUG] Fetching alerts")
+	alerts, respStatus, err := getTestAlerts(api_token, test_ids)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching alerts")
+		return errors.New(respStatus)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	// Keep the latest alert of each test, node and alert sub type. The report times share one ISO 8601 format,
+	// so they order as strings
+	latestAlerts := map[string]TestAlert{}
+	for _, alert := range alerts {
+		key := strings.Join([]string{strconv.Itoa(alert.Test.Id), strconv.Itoa(alert.Node.Id), strconv.Itoa(alert.AlertType.Id), strconv.Itoa(alert.AlertSubType.Id)}, "-")
+		if latest, ok := latestAlerts[key]; !ok || alert.ReportTime > latest.ReportTime {
+			latestAlerts[key] = alert
+		}
+	}
+
+	severity := d.Get("severity").(string)
+	var activeAlerts []TestAlert
+	for _, alert := range latestAlerts {
+		alertSeverity := strings.ToLower(alert.Level.Name)
+		if alertSeverity != "warning" && alertSeverity != "critical" {
+			continue
+		}
+		if severity != "" && alertSeverity != severity {
+			continue
+		}
+		activeAlerts = append(activeAlerts, alert)
+	}
+
+	return setAlertsData(d, m.(*Config), activeAlerts)
+}
+
+func setAlertsData(d *schema.ResourceData, config *Config, alerts []TestAlert) error {
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Test.Id != alerts[j].Test.Id {
+			return alerts[i].Test.Id < alerts[j].Test.Id
+		}
+		if alerts[i].Node.Id != alerts[j].Node.Id {
+			return alerts[i].Node.Id < alerts[j].Node.Id
+		}
+		return alerts[i].AlertSubType.Id < alerts[j].AlertSubType.Id
+	})
+
+	idStrings := make([]string, len(alerts))
+	alertMaps := make([]interface{}, len(alerts))
+	test_ids_alerting := []int{}
+	for i, alert := range alerts {
+		idStrings[i] = string(alert.Id)
+		if len(test_ids_alerting) == 0 || test_ids_alerting[len(test_ids_alerting)-1] != alert.Test.Id {
+			test_ids_alerting = append(test_ids_alerting, alert.Test.Id)
+		}
+		alert_type := getAlertTypeName(alert.AlertType.Id)
+		if alert_type == "" {
+			alert_type = strings.ToLower(alert.AlertType.Name)
+		}
+		alert_sub_type := getAlertSubTypeName(config, alert.AlertSubType.Id)
+		if alert_sub_type == "" {
+			alert_sub_type = strings.ToLower(alert.AlertSubType.Name)
+		}
+		alertMaps[i] = map[string]interface{}{
+			"id":             string(alert.Id),
+			"test_id":        alert.Test.Id,
+			"test_name":      alert.Test.Name,
+			"node_id":        alert.Node.Id,
+			"node_name":      alert.Node.Name,
+			"alert_type":     alert_type,
+			"alert_sub_type": alert_sub_type,
+			"severity":       strings.ToLower(alert.Level.Name),
+			"report_time":    alert.ReportTime,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(idStrings, ","))))
+	d.Set("alerts", alertMaps)
+	d.Set("test_ids_alerting", test_ids_alerting)
+
+	return nil
+}
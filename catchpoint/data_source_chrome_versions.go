@@ -0,0 +1,102 @@
+package catchpoint
+
+import (
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceChromeVersions() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceChromeVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Specific Chrome versions available for chrome_version, newest first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the available Chrome versions, newest first. Each can be used as chrome_version",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"latest": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The newest specific Chrome version",
+			},
+		},
+	}
+}
+
+func dataSourceChromeVersionsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Fetching Chrome versions")
+	catalogue, err := getChromeVersionCatalogue(m.(*Config))
+	if err != nil {
+		log.Printf("[ERROR] Error while fetching Chrome versions")
+		return err
+	}
+
+	chromeVersions := make([]ChromeVersion, len(catalogue))
+	copy(chromeVersions, catalogue)
+	sort.Slice(chromeVersions, func(i, j int) bool {
+		return compareChromeVersions(chromeVersions[i].Name, chromeVersions[j].Name) > 0
+	})
+
+	names := make([]string, len(chromeVersions))
+	versionMaps := make([]interface{}, len(chromeVersions))
+	for i, chromeVersion := range chromeVersions {
+		names[i] = chromeVersion.Name
+		versionMaps[i] = map[string]interface{}{
+			"id":   chromeVersion.Id,
+			"name": chromeVersion.Name,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	d.Set("versions", versionMaps)
+	d.Set("names", names)
+	if len(names) > 0 {
+		d.Set("latest", names[0])
+	}
+
+	return nil
+}
+
+// compareChromeVersions compares dotted version strings numerically, so "108" is newer than "89"
+func compareChromeVersions(a string, b string) int {
+	aParts := strings.Split(a, ".")
+	bParts := strings.Split(b, ".")
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aPart, bPart int
+		if i < len(aParts) {
+			aPart, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bPart, _ = strconv.Atoi(bParts[i])
+		}
+		if aPart != bPart {
+			return aPart - bPart
+		}
+	}
+	return 0
+}
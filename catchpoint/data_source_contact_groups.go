@@ -0,0 +1,121 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceContactGroups() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceContactGroupsRead,
+
+		Schema: map[string]*schema.Schema{
+			"names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Names of the contact groups to resolve. The read fails when a name does not match exactly one contact group. Leave empty to return every contact group",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"division_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Optional. Only consider contact groups of this division",
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the contact groups, in the order of names, or ascending when names is empty",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"contact_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The contact groups, in the same order as ids",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"division_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceContactGroupsRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching contact groups")
+	contactGroups, respStatus, err := getContactGroups(api_token)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching contact groups")
+		return errors.New(respStatus)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	division_id := d.Get("division_id").(int)
+	contactGroupsById := map[int]ContactGroup{}
+	contactGroupIds := map[string][]int{}
+	var ids []int
+	for _, contactGroup := range contactGroups {
+		if division_id != 0 && contactGroup.DivisionId != division_id {
+			continue
+		}
+		contactGroupsById[contactGroup.Id] = contactGroup
+		contactGroupIds[contactGroup.Name] = append(contactGroupIds[contactGroup.Name], contactGroup.Id)
+		ids = append(ids, contactGroup.Id)
+	}
+	sort.Ints(ids)
+
+	if names := d.Get("names").([]interface{}); len(names) > 0 {
+		ids, err = resolveNamesToIds("contact group", names, contactGroupIds)
+		if err != nil {
+			return err
+		}
+	}
+
+	idStrings := make([]string, len(ids))
+	contactGroupMaps := make([]interface{}, len(ids))
+	for i, id := range ids {
+		contactGroup := contactGroupsById[id]
+		idStrings[i] = strconv.Itoa(id)
+		contactGroupMaps[i] = map[string]interface{}{
+			"id":          contactGroup.Id,
+			"name":        contactGroup.Name,
+			"description": contactGroup.Description,
+			"division_id": contactGroup.DivisionId,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(idStrings, ","))))
+	d.Set("ids", ids)
+	d.Set("contact_groups", contactGroupMaps)
+
+	return nil
+}
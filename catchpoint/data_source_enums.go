@@ -0,0 +1,90 @@
+package catchpoint
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceEnums() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceEnumsRead,
+
+		Schema: map[string]*schema.Schema{
+			"catalogue": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The catalogue to read: 'monitors', 'alert_sub_types', 'dns_query_types', 'frequencies', 'reminders', 'test_flags'",
+				ValidateFunc: validation.StringInSlice(getEnumCatalogueNames(), false),
+			},
+			"source": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Where the catalogues in use were loaded from: 'compiled', 'api', 'cache' or 'stale cache'",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The catalogue values ordered by id",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compiled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The catalogue names ordered by id",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceEnumsRead(d *schema.ResourceData, m interface{}) error {
+	catalogueName := d.Get("catalogue").(string)
+	catalogue := getEnumCatalogue(m.(*Config), catalogueName)
+	compiled := compiledEnumCatalogues[catalogueName]
+
+	ids := make([]int, 0, len(catalogue))
+	for id := range catalogue {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	names := make([]string, len(ids))
+	valueMaps := make([]interface{}, len(ids))
+	for i, id := range ids {
+		_, isCompiled := compiled[id]
+		names[i] = catalogue[id]
+		valueMaps[i] = map[string]interface{}{
+			"id":       id,
+			"name":     catalogue[id],
+			"compiled": isCompiled,
+		}
+	}
+
+	d.SetId(catalogueName + "-" + strconv.Itoa(schema.HashString(strings.Join(names, ","))))
+	d.Set("source", getEnumCatalogueSource(m.(*Config)))
+	d.Set("values", valueMaps)
+	d.Set("names", names)
+
+	return nil
+}
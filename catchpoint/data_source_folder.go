@@ -0,0 +1,112 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceFolder() *schema.Resource {
+	folderSchema := inheritedSettingsSchema()
+	folderSchema["path"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Required:     true,
+		Description:  "Required. Path of the folder from the top level folder of its product, separated by '/'. Example: Prod/Web/Checkout",
+		ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[^/]+(/[^/]+)*$`), "path must be folder names separated by single '/', without leading or trailing '/'"),
+	}
+	folderSchema["division_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Required. Division the folder belongs to",
+	}
+	folderSchema["product_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Optional. Product the folder belongs to. Needed when top level folders of several products share the first path segment",
+	}
+	folderSchema["folder_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the folder",
+	}
+	folderSchema["parent_folder_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the parent folder. 0 for top level folders",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceFolderRead,
+		Schema: folderSchema,
+	}
+}
+
+func dataSourceFolderRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	path := d.Get("path").(string)
+	division_id := d.Get("division_id").(int)
+	product_id := d.Get("product_id").(int)
+
+	log.Printf("[DEBUG] Looking up folder by path: " + path)
+	folders, respStatus, err := getFolders(api_token, division_id)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while listing folders")
+		return errors.New(respStatus)
+	}
+
+	// Walk the path one segment at a time, starting from the top level folders
+	folderId := 0
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		folderIds := map[string][]int{}
+		for _, folder := range folders {
+			if folder.ParentId != folderId || (folderId == 0 && product_id != 0 && folder.ProductId != product_id) {
+				continue
+			}
+			folderIds[folder.Name] = append(folderIds[folder.Name], folder.Id)
+		}
+		entityKind := "top level folder"
+		if i > 0 {
+			entityKind = "folder in " + strings.Join(segments[:i], "/")
+		}
+		ids, err := resolveNamesToIds(entityKind, []interface{}{segment}, folderIds)
+		if err != nil {
+			return err
+		}
+		folderId = ids[0]
+	}
+
+	folder, respStatus, err := getFolder(api_token, folderId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching folder %v", folderId)
+		return errors.New(respStatus)
+	}
+	if folder == nil {
+		return errors.New("folder " + strconv.Itoa(folderId) + " was not found")
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.SetId(strconv.Itoa(folder.Id))
+	d.Set("folder_id", folder.Id)
+	d.Set("product_id", folder.ProductId)
+	d.Set("parent_folder_id", folder.ParentId)
+	for key, value := range flattenInheritedSettings(m.(*Config), folder.RequestSettings, folder.AlertGroup, folder.InsightData, folder.ScheduleSettings, folder.AdvancedSettings) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
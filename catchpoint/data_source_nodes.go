@@ -0,0 +1,227 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceNodes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNodesRead,
+
+		Schema: map[string]*schema.Schema{
+			"countries": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes located in one of these countries. Case insensitive",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes located in one of these cities. Case insensitive",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"regions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes located in one of these regions. Case insensitive",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"isps": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes hosted by one of these ISPs. Case insensitive",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"asns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes announced by one of these autonomous system numbers",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"network_types": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. Only return nodes of these network types: 'backbone', 'last mile', 'wireless', 'cloud', 'enterprise'",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"backbone", "last mile", "wireless", "cloud", "enterprise"}, false),
+				},
+			},
+			"ip_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return nodes supporting this IP version: 'ipv4' or 'ipv6'. Dual stack nodes match both",
+				ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return nodes with this status: 'active' or 'inactive'",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the matching nodes in ascending order. Can be passed straight to schedule_settings.node_ids",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching nodes with their metadata",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"city": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"isp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"asn": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"network_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNodesRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching nodes")
+	nodes, respStatus, err := getNodes(api_token)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching nodes")
+		return errors.New(respStatus)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	countries := getLowerCaseStringSet(d.Get("countries").([]interface{}))
+	cities := getLowerCaseStringSet(d.Get("cities").([]interface{}))
+	regions := getLowerCaseStringSet(d.Get("regions").([]interface{}))
+	isps := getLowerCaseStringSet(d.Get("isps").([]interface{}))
+	asns := map[int]bool{}
+	for _, asn := range d.Get("asns").([]interface{}) {
+		asns[asn.(int)] = true
+	}
+	networkTypeIds := map[int]bool{}
+	for _, network_type := range d.Get("network_types").([]interface{}) {
+		network_type_id, _ := getNetworkTypeId(network_type.(string))
+		networkTypeIds[network_type_id] = true
+	}
+	ip_version := d.Get("ip_version").(string)
+	status := d.Get("status").(string)
+
+	var matches []NodeDetail
+	for _, node := range nodes {
+		if len(countries) > 0 && !countries[strings.ToLower(node.Country.Name)] {
+			continue
+		}
+		if len(cities) > 0 && !cities[strings.ToLower(node.City.Name)] {
+			continue
+		}
+		if len(regions) > 0 && !regions[strings.ToLower(node.Region.Name)] {
+			continue
+		}
+		if len(isps) > 0 && !isps[strings.ToLower(node.Isp.Name)] {
+			continue
+		}
+		if len(asns) > 0 && !asns[node.Asn] {
+			continue
+		}
+		if len(networkTypeIds) > 0 && !networkTypeIds[node.NetworkType.Id] {
+			continue
+		}
+		node_ip_version := getIpVersionName(node.IpVersion.Id)
+		if ip_version != "" && node_ip_version != ip_version && node_ip_version != "dual stack" {
+			continue
+		}
+		if status != "" && strings.ToLower(node.Status.Name) != status {
+			continue
+		}
+		matches = append(matches, node)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Id < matches[j].Id })
+
+	ids := make([]int, len(matches))
+	idStrings := make([]string, len(matches))
+	nodeMaps := make([]interface{}, len(matches))
+	for i, node := range matches {
+		ids[i] = node.Id
+		idStrings[i] = strconv.Itoa(node.Id)
+		nodeMaps[i] = map[string]interface{}{
+			"id":           node.Id,
+			"name":         node.Name,
+			"country":      node.Country.Name,
+			"city":         node.City.Name,
+			"region":       node.Region.Name,
+			"isp":          node.Isp.Name,
+			"asn":          node.Asn,
+			"network_type": getNetworkTypeName(node.NetworkType.Id),
+			"ip_version":   getIpVersionName(node.IpVersion.Id),
+			"status":       strings.ToLower(node.Status.Name),
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(idStrings, ","))))
+	d.Set("ids", ids)
+	d.Set("nodes", nodeMaps)
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// inheritedSettingsSchema returns the settings blocks that products and folders pass on to their tests, shaped
+// like the same blocks of the catchpoint_test data source
+func inheritedSettingsSchema() map[string]*schema.Schema {
+	testSchema := dataSourceTestType().Schema
+	settingsSchema := map[string]*schema.Schema{}
+	for _, key := range []string{"request_settings", "alert_settings", "insights", "schedule_settings", "advanced_settings"} {
+		settingsSchema[key] = testSchema[key]
+	}
+	return settingsSchema
+}
+
+func flattenInheritedSettings(config *Config, requestSettings RequestSetting, alertGroup AlertGroupStruct, insightData InsightDataStruct, scheduleSettings ScheduleSetting, advancedSettings AdvancedSetting) map[string]interface{} {
+	return map[string]interface{}{
+		"request_settings":  flattenRequestSetting(requestSettings),
+		"alert_settings":    flattenAlertGroupStruct(config, alertGroup),
+		"insights":          flattenInsightDataStruct(insightData),
+		"schedule_settings": flattenScheduleSetting(config, scheduleSettings),
+		"advanced_settings": flattenAdvancedSetting(advancedSettings),
+	}
+}
+
+func dataSourceProduct() *schema.Resource {
+	productSchema := inheritedSettingsSchema()
+	productSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "Required. Name of the product. Must be unique within the division",
+	}
+	productSchema["division_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Required:    true,
+		Description: "Required. Division the product belongs to",
+	}
+	productSchema["product_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Computed:    true,
+		Description: "ID of the product",
+	}
+	productSchema["status"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Status of the product: 'active' or 'inactive'",
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceProductRead,
+		Schema: productSchema,
+	}
+}
+
+func dataSourceProductRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+	division_id := d.Get("division_id").(int)
+
+	log.Printf("[DEBUG] Looking up product by name: " + name)
+	products, respStatus, err := getProducts(api_token, division_id)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while listing products")
+		return errors.New(respStatus)
+	}
+	productIds := map[string][]int{}
+	for _, product := range products {
+		productIds[product.Name] = append(productIds[product.Name], product.Id)
+	}
+	ids, err := resolveNamesToIds("product", []interface{}{name}, productIds)
+	if err != nil {
+		return err
+	}
+
+	product, respStatus, err := getProduct(api_token, ids[0])
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while fetching product %v", ids[0])
+		return errors.New(respStatus)
+	}
+	if product == nil {
+		return errors.New("product " + strconv.Itoa(ids[0]) + " was not found")
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.SetId(strconv.Itoa(product.Id))
+	d.Set("product_id", product.Id)
+	d.Set("status", strings.ToLower(product.Status.Name))
+	for key, value := range flattenInheritedSettings(m.(*Config), product.RequestSettings, product.AlertGroup, product.InsightData, product.ScheduleSettings, product.AdvancedSettings) {
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
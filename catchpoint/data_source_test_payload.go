@@ -0,0 +1,186 @@
+package catchpoint
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+type testPayloadRenderer struct {
+	resource               func() *schema.Resource
+	buildTestConfig        func(d *schema.ResourceData, m interface{}) (TestConfig, error)
+	buildTestJsonPatchDocs func(d *schema.ResourceData, m interface{}) ([]string, error)
+}
+
+// testPayloadRenderers maps every test resource to the functions its create and update use to build the request
+// payloads. Each one is a block of the catchpoint_test_payload data source
+func testPayloadRenderers() map[string]testPayloadRenderer {
+	return map[string]testPayloadRenderer{
+		"web_test":         {resourceWebTestType, buildWebTestConfig, buildWebTestJsonPatchDocs},
+		"api_test":         {resourceApiTestType, buildApiTestConfig, buildApiTestJsonPatchDocs},
+		"transaction_test": {resourceTransactionTestType, buildTransactionTestConfig, buildTransactionTestJsonPatchDocs},
+		"traceroute_test":  {resourceTracerouteTestType, buildTracerouteTestConfig, buildTracerouteTestJsonPatchDocs},
+		"ping_test":        {resourcePingTestType, buildPingTestConfig, buildPingTestJsonPatchDocs},
+		"bgp_test":         {resourceBgpTestType, buildBgpTestConfig, buildBgpTestJsonPatchDocs},
+		"dns_test":         {resourceDnsTestType, buildDnsTestConfig, buildDnsTestJsonPatchDocs},
+		"ssl_test":         {resourceSslTestType, buildSslTestConfig, buildSslTestJsonPatchDocs},
+		"smtp_test":        {resourceSmtpTestType, buildSmtpTestConfig, buildSmtpTestJsonPatchDocs},
+		"ftp_test":         {resourceFtpTestType, buildFtpTestConfig, buildFtpTestJsonPatchDocs},
+		"tcp_test":         {resourceTcpTestType, buildTcpTestConfig, buildTcpTestJsonPatchDocs},
+		"udp_test":         {resourceUdpTestType, buildUdpTestConfig, buildUdpTestJsonPatchDocs},
+		"ntp_test":         {resourceNtpTestType, buildNtpTestConfig, buildNtpTestJsonPatchDocs},
+		"websocket_test":   {resourceWebSocketTestType, buildWebSocketTestConfig, buildWebSocketTestJsonPatchDocs},
+		"mqtt_test":        {resourceMqttTestType, buildMqttTestConfig, buildMqttTestJsonPatchDocs},
+		"imap_test":        {resourceImapTestType, buildImapTestConfig, buildImapTestJsonPatchDocs},
+		"pop_test":         {resourcePopTestType, buildPopTestConfig, buildPopTestJsonPatchDocs},
+		"streaming_test":   {resourceStreamingTestType, buildStreamingTestConfig, buildStreamingTestJsonPatchDocs},
+		"custom_test":      {resourceCustomTestType, buildCustomTestConfig, buildCustomTestJsonPatchDocs},
+		"playwright_test":  {resourcePlaywrightTestType, buildPlaywrightTestConfig, buildPlaywrightTestJsonPatchDocs},
+		"puppeteer_test":   {resourcePuppeteerTestType, buildPuppeteerTestConfig, buildPuppeteerTestJsonPatchDocs},
+	}
+}
+
+func dataSourceTestPayload() *schema.Resource {
+	renderers := testPayloadRenderers()
+	blockNames := make([]string, 0, len(renderers))
+	for blockName := range renderers {
+		blockNames = append(blockNames, blockName)
+	}
+	sort.Strings(blockNames)
+
+	payloadSchema := map[string]*schema.Schema{
+		"json": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON the resource sends to create the test. The change date and label colors are generated on every read, like they are on create",
+		},
+		"json_patch": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The JSON Patch document the resource sends to update the test when every set argument changed",
+		},
+	}
+	for _, blockName := range blockNames {
+		payloadSchema[blockName] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			Description:  "Optional. Arguments of a " + blockName + " resource to render. Exactly one test block must be set",
+			ExactlyOneOf: blockNames,
+			Elem: &schema.Resource{
+				Schema: getTestPayloadArgumentsSchema(blockName, renderers[blockName].resource()),
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceTestPayloadRead,
+		Schema: payloadSchema,
+	}
+}
+
+func dataSourceTestPayloadRead(d *schema.ResourceData, m interface{}) error {
+	for blockName, renderer := range testPayloadRenderers() {
+		blocks := d.Get(blockName).([]interface{})
+		if len(blocks) == 0 {
+			continue
+		}
+		if blocks[0] == nil {
+			return errors.New(blockName + " must set the arguments its resource requires")
+		}
+
+		// Diff the block against empty state, so that the builders see every set argument as new and changed
+		testSchema := schema.InternalMap(renderer.resource().Schema)
+		testConfigRaw := terraform.NewResourceConfigRaw(getTestPayloadConfigValue(blocks[0]).(map[string]interface{}))
+		testDiff, err := testSchema.Diff(context.Background(), nil, testConfigRaw, nil, nil, true)
+		if err != nil {
+			return err
+		}
+		testData, err := testSchema.Data(nil, testDiff)
+		if err != nil {
+			return err
+		}
+		renderConfig := newTestPayloadConfig(m.(*Config))
+
+		testConfig, err := renderer.buildTestConfig(testData, renderConfig)
+		if err != nil {
+			return err
+		}
+		jsonPatchDocs, err := renderer.buildTestJsonPatchDocs(testData, renderConfig)
+		if err != nil {
+			return err
+		}
+		jsonStr := createJson(testConfig)
+
+		d.SetId(blockName + "-" + strconv.Itoa(schema.HashString(jsonStr)))
+		d.Set("json", jsonStr)
+		d.Set("json_patch", "["+strings.Join(jsonPatchDocs, ",")+"]")
+		return nil
+	}
+	return errors.New("one test block must be set")
+}
+
+// getTestPayloadArgumentsSchema drops the computed-only attributes of a test resource, which cannot be configured
+func getTestPayloadArgumentsSchema(blockName string, resource *schema.Resource) map[string]*schema.Schema {
+	argumentsSchema := map[string]*schema.Schema{}
+	for key, attributeSchema := range resource.Schema {
+		if attributeSchema.Computed && !attributeSchema.Optional {
+			continue
+		}
+		// ExactlyOneOf keys are paths from the top of the schema, which is now the block
+		if len(attributeSchema.ExactlyOneOf) > 0 {
+			blockSchema := *attributeSchema
+			blockSchema.ExactlyOneOf = make([]string, len(attributeSchema.ExactlyOneOf))
+			for i, exactlyOneOfKey := range attributeSchema.ExactlyOneOf {
+				blockSchema.ExactlyOneOf[i] = blockName + ".0." + exactlyOneOfKey
+			}
+			attributeSchema = &blockSchema
+		}
+		argumentsSchema[key] = attributeSchema
+	}
+	return argumentsSchema
+}
+
+// getTestPayloadConfigValue turns a block read from the data source back into configuration, where sets are lists
+func getTestPayloadConfigValue(value interface{}) interface{} {
+	switch value := value.(type) {
+	case *schema.Set:
+		return getTestPayloadConfigValue(value.List())
+	case []interface{}:
+		values := make([]interface{}, len(value))
+		for i, element := range value {
+			values[i] = getTestPayloadConfigValue(element)
+		}
+		return values
+	case map[string]interface{}:
+		values := map[string]interface{}{}
+		for key, element := range value {
+			values[key] = getTestPayloadConfigValue(element)
+		}
+		return values
+	}
+	return value
+}
+
+// newTestPayloadConfig returns a copy of the provider configuration that never calls the API. Specific Chrome
+// versions resolve against the catalogue the provider already fetched, or the built-in list if it has not
+func newTestPayloadConfig(config *Config) *Config {
+	config.chromeVersionsMutex.Lock()
+	defer config.chromeVersionsMutex.Unlock()
+
+	chromeVersions := config.chromeVersions
+	if chromeVersions == nil {
+		chromeVersions = defaultChromeVersions
+	}
+	return &Config{
+		Environment:         config.Environment,
+		chromeVersions:      chromeVersions,
+		enumCatalogues:      config.enumCatalogues,
+		enumCatalogueSource: config.enumCatalogueSource,
+	}
+}
@@ -0,0 +1,251 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// performanceMetricsSchema returns the computed metrics reported for all runs, for each test and for each node
+func performanceMetricsSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"run_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of runs in the window",
+		},
+		"error_count": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Number of runs that failed",
+		},
+		"availability": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Percentage of runs without an error. 0 when there were no runs",
+		},
+		"test_time_avg": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Average test time in milliseconds",
+		},
+		"test_time_p50": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Median test time in milliseconds",
+		},
+		"test_time_p95": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "95th percentile test time in milliseconds",
+		},
+		"test_time_p99": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "99th percentile test time in milliseconds",
+		},
+		"test_time_max": {
+			Type:        schema.TypeFloat,
+			Computed:    true,
+			Description: "Slowest test time in milliseconds",
+		},
+	}
+}
+
+func dataSourceTestPerformance() *schema.Resource {
+	testSchema := performanceMetricsSchema()
+	testSchema["test_id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	nodeSchema := performanceMetricsSchema()
+	nodeSchema["node_id"] = &schema.Schema{
+		Type:     schema.TypeInt,
+		Computed: true,
+	}
+	nodeSchema["node_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Computed: true,
+	}
+	testSchema["nodes"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Metrics of the test per node, ordered by node id",
+		Elem: &schema.Resource{
+			Schema: nodeSchema,
+		},
+	}
+
+	dataSourceSchema := performanceMetricsSchema()
+	dataSourceSchema["test_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Required:    true,
+		MinItems:    1,
+		Description: "Required. IDs of the tests to aggregate the latest runs of",
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+	dataSourceSchema["lookback_minutes"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Default:      60,
+		Description:  "Optional. Length of the window ending now that runs are aggregated over, in minutes. Defaults to 60",
+		ValidateFunc: validation.IntBetween(1, 10080),
+	}
+	dataSourceSchema["node_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Optional. Only aggregate runs from these nodes",
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+	dataSourceSchema["start_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Start of the aggregated window in RFC 3339 format",
+	}
+	dataSourceSchema["end_time"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "End of the aggregated window in RFC 3339 format",
+	}
+	dataSourceSchema["tests"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Metrics per test, in the order of test_ids",
+		Elem: &schema.Resource{
+			Schema: testSchema,
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceTestPerformanceRead,
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceTestPerformanceRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	endTime := time.Now().UTC().Truncate(time.Minute)
+	startTime := endTime.Add(-time.Duration(d.Get("lookback_minutes").(int)) * time.Minute)
+	var node_ids []int
+	for _, node_id := range d.Get("node_ids").([]interface{}) {
+		node_ids = append(node_ids, node_id.(int))
+	}
+
+	var allRuns []TestRun
+	testIdStrings := []string{}
+	testMaps := []interface{}{}
+	for _, test_id := range d.Get("test_ids").([]interface{}) {
+		testId := test_id.(int)
+		log.Printf("[DEBUG] Fetching performance data of test %v", testId)
+		runs, respStatus, err := getTestRuns(api_token, testId, startTime, endTime, node_ids)
+		if err != nil {
+			return err
+		}
+		if respStatus != "200 ok" {
+			log.Printf("[ERROR] Error while fetching performance data of test %v", testId)
+			return errors.New(respStatus)
+		}
+		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+		nodeRuns := map[int][]TestRun{}
+		for _, run := range runs {
+			nodeRuns[run.NodeId] = append(nodeRuns[run.NodeId], run)
+		}
+		nodeIds := make([]int, 0, len(nodeRuns))
+		for nodeId := range nodeRuns {
+			nodeIds = append(nodeIds, nodeId)
+		}
+		sort.Ints(nodeIds)
+		nodeMaps := make([]interface{}, len(nodeIds))
+		for i, nodeId := range nodeIds {
+			nodeMap := summarizeTestRuns(nodeRuns[nodeId])
+			nodeMap["node_id"] = nodeId
+			nodeMap["node_name"] = nodeRuns[nodeId][0].NodeName
+			nodeMaps[i] = nodeMap
+		}
+
+		testMap := summarizeTestRuns(runs)
+		testMap["test_id"] = testId
+		testMap["nodes"] = nodeMaps
+		testMaps = append(testMaps, testMap)
+		testIdStrings = append(testIdStrings, strconv.Itoa(testId))
+		allRuns = append(allRuns, runs...)
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(testIdStrings, ","))))
+	for key, value := range summarizeTestRuns(allRuns) {
+		d.Set(key, value)
+	}
+	d.Set("start_time", startTime.Format(time.RFC3339))
+	d.Set("end_time", endTime.Format(time.RFC3339))
+	if err := d.Set("tests", testMaps); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// summarizeTestRuns aggregates runs into the attributes of performanceMetricsSchema. Runs without a test time,
+// typically failed ones, count towards availability but not the test time statistics
+func summarizeTestRuns(runs []TestRun) map[string]interface{} {
+	errorCount := 0
+	var testTimes []float64
+	for _, run := range runs {
+		if run.Failed {
+			errorCount++
+		}
+		if run.HasTestTime {
+			testTimes = append(testTimes, run.TestTime)
+		}
+	}
+	sort.Float64s(testTimes)
+
+	availability := 0.0
+	if len(runs) > 0 {
+		availability = float64(len(runs)-errorCount) * 100 / float64(len(runs))
+	}
+	testTimeAvg := 0.0
+	testTimeMax := 0.0
+	if len(testTimes) > 0 {
+		for _, testTime := range testTimes {
+			testTimeAvg += testTime
+		}
+		testTimeAvg /= float64(len(testTimes))
+		testTimeMax = testTimes[len(testTimes)-1]
+	}
+
+	return map[string]interface{}{
+		"run_count":     len(runs),
+		"error_count":   errorCount,
+		"availability":  availability,
+		"test_time_avg": testTimeAvg,
+		"test_time_p50": getPercentile(testTimes, 50),
+		"test_time_p95": getPercentile(testTimes, 95),
+		"test_time_p99": getPercentile(testTimes, 99),
+		"test_time_max": testTimeMax,
+	}
+}
+
+// getPercentile uses the nearest rank method on sorted values, returning 0 when there are none
+func getPercentile(sortedValues []float64, percentile float64) float64 {
+	if len(sortedValues) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(percentile / 100 * float64(len(sortedValues))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sortedValues[rank-1]
+}
@@ -0,0 +1,393 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// testTypeResources lists every test resource. The test data sources expose the union of their schemas
+func testTypeResources() []*schema.Resource {
+	return []*schema.Resource{
+		resourceWebTestType(),
+		resourceApiTestType(),
+		resourceTransactionTestType(),
+		resourceTracerouteTestType(),
+		resourcePingTestType(),
+		resourceBgpTestType(),
+		resourceDnsTestType(),
+		resourceSslTestType(),
+		resourceSmtpTestType(),
+		resourceFtpTestType(),
+		resourceTcpTestType(),
+		resourceUdpTestType(),
+		resourceNtpTestType(),
+		resourceWebSocketTestType(),
+		resourceMqttTestType(),
+		resourceImapTestType(),
+		resourcePopTestType(),
+		resourceStreamingTestType(),
+		resourceCustomTestType(),
+		resourcePlaywrightTestType(),
+		resourcePuppeteerTestType(),
+	}
+}
+
+func dataSourceTestType() *schema.Resource {
+	testSchema := computedSchemaFromResources(testTypeResources())
+
+	testSchema["test_id"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Optional. ID of the test to read. Either test_id or test_name must be set",
+		ExactlyOneOf: []string{"test_id", "test_name"},
+	}
+	testSchema["test_name"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		Description:  "Optional. Name of the test to read. Must be unique within division_id and product_id when used for the lookup",
+		ExactlyOneOf: []string{"test_id", "test_name"},
+	}
+	testSchema["division_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Optional. Restricts a lookup by test_name to this division",
+	}
+	testSchema["product_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Optional:    true,
+		Computed:    true,
+		Description: "Optional. Restricts a lookup by test_name to this product",
+	}
+	testSchema["test_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Type of the test. Example: web, api, transaction, dns",
+	}
+	// flattenTest returns a few attributes that none of the test resources declare
+	testSchema["change_date"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "Date of the last change to the test",
+	}
+	testSchema["user_agent_type"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "User agent the test simulates, if any",
+	}
+	addAlertRuleWebhookIdsSchema(testSchema["alert_settings"])
+
+	return withEffectiveSettings(&schema.Resource{
+		Read:   dataSourceTestTypeRead,
+		Schema: testSchema,
+	}, testSchema)
+}
+
+func dataSourceTestsType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTestsTypeRead,
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Optional. Only return tests of this division",
+			},
+			"product_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Optional. Only return tests of this product",
+			},
+			"folder_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Optional. Only return tests directly within this folder",
+			},
+			"test_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return tests of this type: 'web', 'api', 'transaction', 'traceroute', 'ping', 'bgp', 'dns', 'ssl', 'playwright', 'puppeteer', 'smtp', 'ftp', 'tcp', 'udp', 'ntp', 'websocket', 'mqtt', 'imap', 'pop', 'streaming', 'custom'",
+				ValidateFunc: validation.StringInSlice([]string{"web", "api", "transaction", "traceroute", "ping", "bgp", "dns", "ssl", "playwright", "puppeteer", "smtp", "ftp", "tcp", "udp", "ntp", "websocket", "mqtt", "imap", "pop", "streaming", "custom"}, false),
+			},
+			"monitor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. Only return tests using this monitor. Example: chrome, object, ping icmp",
+			},
+			"label_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. Only return tests carrying a label with this key",
+			},
+			"label_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return tests whose label_key label has this value",
+				RequiredWith: []string{"label_key"},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Optional. Only return tests with this status: 'active' or 'inactive'",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+			"ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the matching tests in ascending order",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"tests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The matching tests. Use the catchpoint_test data source to read the full settings of a test",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"test_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"monitor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"division_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"product_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"folder_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"test_url": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTestTypeRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	testId := strconv.Itoa(d.Get("test_id").(int))
+	if testId == "0" {
+		name := d.Get("test_name").(string)
+		division_id := d.Get("division_id").(int)
+		product_id := d.Get("product_id").(int)
+
+		log.Printf("[DEBUG] Looking up test by name: " + name)
+		tests, respStatus, err := getTests(api_token)
+		if err != nil {
+			return err
+		}
+		if respStatus != "200 ok" {
+			log.Printf("[ERROR] Error while listing tests")
+			return errors.New(respStatus)
+		}
+		var matchIds []string
+		for _, test := range tests {
+			if test.Name != name || (division_id != 0 && test.DivisionId != division_id) || (product_id != 0 && test.ProductId != product_id) {
+				continue
+			}
+			matchIds = append(matchIds, strconv.Itoa(test.Id))
+		}
+		if len(matchIds) == 0 {
+			return errors.New("no test named " + name + " was found")
+		}
+		if len(matchIds) > 1 {
+			return errors.New("test name " + name + " matches tests " + strings.Join(matchIds, ", ") + ". set division_id and product_id or use test_id")
+		}
+		testId = matchIds[0]
+	}
+
+	log.Printf("[DEBUG] Fetching test: %v", testId)
+	test, respStatus, err := getTest(api_token, testId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading test: %v", testId)
+		return errors.New(respStatus)
+	}
+	if test == nil {
+		return errors.New("test " + testId + " was not found")
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	testNew := flattenTest(m.(*Config), test)
+	for key, value := range testNew {
+		if key == "id" {
+			continue
+		}
+		if err := d.Set(key, value); err != nil {
+			return err
+		}
+	}
+	d.Set("chrome_version", getTestChromeVersionName(test, m))
+	d.Set("test_id", test.Id)
+	d.Set("test_type", getTestTypeName(test.TestType.Id))
+	if err := setEffectiveSettings(d, m.(*Config), testId); err != nil {
+		return err
+	}
+	d.SetId(testId)
+
+	return nil
+}
+
+func dataSourceTestsTypeRead(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching tests")
+	tests, respStatus, err := getTests(api_token)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while listing tests")
+		return errors.New(respStatus)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	division_id := d.Get("division_id").(int)
+	product_id := d.Get("product_id").(int)
+	folder_id := d.Get("folder_id").(int)
+	test_type := d.Get("test_type").(string)
+	monitor := d.Get("monitor").(string)
+	label_key := d.Get("label_key").(string)
+	label_value := d.Get("label_value").(string)
+	status := d.Get("status").(string)
+
+	var matches []Test
+	for _, test := range tests {
+		if division_id != 0 && test.DivisionId != division_id {
+			continue
+		}
+		if product_id != 0 && test.ProductId != product_id {
+			continue
+		}
+		if folder_id != 0 && test.FolderId != folder_id {
+			continue
+		}
+		if test_type != "" && getTestTypeName(test.TestType.Id) != test_type {
+			continue
+		}
+		if monitor != "" && getMonitorName(m.(*Config), test.Monitor.Id) != monitor {
+			continue
+		}
+		if label_key != "" && !hasTestLabel(test.Labels, label_key, label_value) {
+			continue
+		}
+		if status != "" && strings.ToLower(test.Status.Name) != status {
+			continue
+		}
+		matches = append(matches, test)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Id < matches[j].Id })
+
+	ids := make([]int, len(matches))
+	idStrings := make([]string, len(matches))
+	testMaps := make([]interface{}, len(matches))
+	for i, test := range matches {
+		ids[i] = test.Id
+		idStrings[i] = strconv.Itoa(test.Id)
+		testMaps[i] = map[string]interface{}{
+			"id":          test.Id,
+			"test_name":   test.Name,
+			"test_type":   getTestTypeName(test.TestType.Id),
+			"monitor":     getMonitorName(m.(*Config), test.Monitor.Id),
+			"division_id": test.DivisionId,
+			"product_id":  test.ProductId,
+			"folder_id":   test.FolderId,
+			"status":      strings.ToLower(test.Status.Name),
+			"test_url":    test.Url,
+		}
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join(idStrings, ","))))
+	d.Set("ids", ids)
+	d.Set("tests", testMaps)
+
+	return nil
+}
+
+func hasTestLabel(labels []Label, key string, value string) bool {
+	for _, label := range labels {
+		if label.Name != key {
+			continue
+		}
+		if value == "" {
+			return true
+		}
+		for _, labelValue := range label.Values {
+			if labelValue == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// computedSchemaFromResources merges the schemas of the given resources into one computed-only schema
+func computedSchemaFromResources(resources []*schema.Resource) map[string]*schema.Schema {
+	merged := map[string]*schema.Schema{}
+	for _, resource := range resources {
+		mergeComputedSchema(merged, resource.Schema)
+	}
+	return merged
+}
+
+func mergeComputedSchema(merged map[string]*schema.Schema, source map[string]*schema.Schema) {
+	for key, sourceSchema := range source {
+		mergedSchema, ok := merged[key]
+		if !ok {
+			// Descriptions are dropped since they are worded for a single test type
+			mergedSchema = &schema.Schema{
+				Type:      sourceSchema.Type,
+				Computed:  true,
+				Sensitive: sourceSchema.Sensitive,
+			}
+			switch elem := sourceSchema.Elem.(type) {
+			case *schema.Resource:
+				mergedSchema.Elem = &schema.Resource{Schema: map[string]*schema.Schema{}}
+			case *schema.Schema:
+				mergedSchema.Elem = &schema.Schema{Type: elem.Type}
+			}
+			merged[key] = mergedSchema
+		}
+		sourceElem, sourceIsResource := sourceSchema.Elem.(*schema.Resource)
+		mergedElem, mergedIsResource := mergedSchema.Elem.(*schema.Resource)
+		if sourceIsResource && mergedIsResource {
+			mergeComputedSchema(mergedElem.Schema, sourceElem.Schema)
+		}
+	}
+}
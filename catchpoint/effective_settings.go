@@ -0,0 +1,73 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// inheritableSettingsKeys are the settings blocks a test can inherit from its folder, product or division
+var inheritableSettingsKeys = []string{"request_settings", "alert_settings", "insights", "schedule_settings", "advanced_settings"}
+
+// withEffectiveSettings adds a computed effective_<block> attribute for each inheritable settings block of a test
+// resource. They hold the settings that apply to the test, inherited or not, and are never part of a diff. Their
+// schema is the canonical settingsSchema of all test types, since inherited settings are not limited to the
+// attributes a single test type declares
+func withEffectiveSettings(resource *schema.Resource, settingsSchema map[string]*schema.Schema) *schema.Resource {
+	for _, key := range inheritableSettingsKeys {
+		if _, ok := resource.Schema[key]; !ok {
+			continue
+		}
+		effectiveSchema := *settingsSchema[key]
+		effectiveSchema.Description = "The " + key + " that apply to the test, including the ones inherited from its folder, product and division"
+		resource.Schema["effective_"+key] = &effectiveSchema
+	}
+	return resource
+}
+
+// addAlertRuleWebhookIdsSchema declares the alert_webhook_ids that flattenAlertGroupStruct returns for alert rule
+// notification groups on a computed alert_settings schema
+func addAlertRuleWebhookIdsSchema(alertSettingsSchema *schema.Schema) {
+	alertSettings := alertSettingsSchema.Elem.(*schema.Resource)
+	alertRule := alertSettings.Schema["alert_rule"].Elem.(*schema.Resource)
+	alertRuleNotificationGroup := alertRule.Schema["notification_group"].Elem.(*schema.Resource)
+	alertRuleNotificationGroup.Schema["alert_webhook_ids"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Alert webhook ids of the alert rule notification group",
+		Elem: &schema.Schema{
+			Type: schema.TypeInt,
+		},
+	}
+}
+
+// setEffectiveSettings reads the test again with its inherited properties and sets the effective_<block> attributes
+// declared on d
+func setEffectiveSettings(d *schema.ResourceData, config *Config, testId string) error {
+	log.Printf("[DEBUG] Fetching effective settings of test: %v", testId)
+	test, respStatus, err := getEffectiveTest(config.ApiToken, testId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading effective settings of test: %v", testId)
+		return errors.New(respStatus)
+	}
+	if test == nil {
+		return nil
+	}
+
+	effectiveSettings := flattenInheritedSettings(config, test.RequestSettings, test.AlertGroup, test.InsightData, test.ScheduleSettings, test.AdvancedSettings)
+	for _, key := range inheritableSettingsKeys {
+		// Get returns nil for attributes the schema does not declare, such as effective_insights on a ping test
+		if d.Get("effective_"+key) == nil {
+			continue
+		}
+		// The effective settings are informational, so a value that does not fit the schema must not fail the refresh
+		if err := d.Set("effective_"+key, effectiveSettings[key]); err != nil {
+			log.Printf("[WARN] Could not set effective_%v of test %v: %v", key, testId, err)
+		}
+	}
+	return nil
+}
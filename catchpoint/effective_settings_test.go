@@ -0,0 +1,81 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// effectiveSettingsTest is a test whose inherited settings hold attributes that only some test types declare
+func effectiveSettingsTest() Test {
+	return Test{
+		Id:       1,
+		Name:     "Effective settings",
+		Url:      "https://www.catchpoint.com",
+		TestType: GenericIdName{Id: int(Web), Name: "Web"},
+		Monitor:  GenericIdName{Id: 18, Name: "Chrome"},
+		Status:   GenericIdName{Id: 0, Name: "Active"},
+		RequestSettings: RequestSetting{
+			RequestSettingType: GenericIdName{Id: 1, Name: "Inherit"},
+			HttpHeaderRequests: []HttpHeaderRequest{
+				{RequestValue: "terraform", RequestHeaderType: GenericIdName{Id: 1, Name: "User Agent"}},
+			},
+		},
+		AdvancedSettings: AdvancedSetting{
+			AdvancedSettingType:     GenericIdName{Id: 1, Name: "Inherit"},
+			AdditionalMonitor:       &GenericIdNameOmitEmpty{Id: 8, Name: "Ping ICMP"},
+			TestBandwidthThrottling: &GenericIdNameOmitEmpty{Id: 1, Name: "GPRS"},
+		},
+	}
+}
+
+// getEffectiveSettingsBlock returns the effective_<key> block set on d, or nil if the resource does not declare it
+func getEffectiveSettingsBlock(d *schema.ResourceData, key string) map[string]interface{} {
+	effectiveSettings, ok := d.Get("effective_" + key).(*schema.Set)
+	if !ok || effectiveSettings.Len() == 0 {
+		return nil
+	}
+	return effectiveSettings.List()[0].(map[string]interface{})
+}
+
+func TestTestResourcesReadEffectiveSettings(t *testing.T) {
+	testJson, _ := json.Marshal(map[string]interface{}{
+		"data":      map[string]interface{}{"tests": []Test{effectiveSettingsTest()}},
+		"completed": true,
+	})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testJson)
+	}))
+	defer server.Close()
+
+	defaultTestURI := catchpointTestURI
+	catchpointTestURI = server.URL
+	defer func() { catchpointTestURI = defaultTestURI }()
+
+	provider := Provider()
+	for name := range testPayloadRenderers() {
+		resource := provider.ResourcesMap[name]
+		d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{})
+		d.SetId("1")
+
+		if err := resource.Read(d, newConfig("token", false, "")); err != nil {
+			t.Errorf("%v: unexpected error reading the test: %v", name, err)
+			continue
+		}
+		for _, key := range inheritableSettingsKeys {
+			_, declared := resource.Schema[key]
+			if _, ok := resource.Schema["effective_"+key]; ok != declared {
+				t.Errorf("%v: effective_%v is declared %v, want %v", name, key, ok, declared)
+			}
+		}
+		if requestSettings := getEffectiveSettingsBlock(d, "request_settings"); requestSettings != nil && requestSettings["http_request_headers"].(*schema.Set).Len() == 0 {
+			t.Errorf("%v: effective_request_settings http_request_headers is not set", name)
+		}
+		if advancedSettings := getEffectiveSettingsBlock(d, "advanced_settings"); advancedSettings != nil && advancedSettings["bandwidth_throttling"] != "gprs" {
+			t.Errorf("%v: effective_advanced_settings bandwidth_throttling is %v, want gprs", name, advancedSettings["bandwidth_throttling"])
+		}
+	}
+}
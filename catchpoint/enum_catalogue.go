@@ -0,0 +1,245 @@
+package catchpoint
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// compiledEnumCatalogues are the id to name maps built into the provider. They are always available and
+// are the offline fallback when live enums are enabled but neither the API nor the disk cache can be read
+var compiledEnumCatalogues = map[string]map[int]string{
+	"monitors":        monitorTypes,
+	"alert_sub_types": alertSubTypes,
+	"dns_query_types": queryTypes,
+	"frequencies":     frequencies,
+	"reminders":       reminders,
+	"test_flags":      testFlagTypes,
+}
+
+// enumCatalogueEndpoints are the reference endpoints the live catalogues are loaded from
+var enumCatalogueEndpoints = map[string]string{
+	"monitors":        "/reference/monitors",
+	"alert_sub_types": "/reference/alertsubtypes",
+	"dns_query_types": "/reference/dnsquerytypes",
+	"frequencies":     "/reference/frequencies",
+	"reminders":       "/reference/reminderintervals",
+	"test_flags":      "/reference/testflags",
+}
+
+// enumCatalogueAttributes maps the test attributes that take a catalogue value to the catalogue they are validated against
+var enumCatalogueAttributes = map[string]string{
+	"monitor":           "monitors",
+	"query_type":        "dns_query_types",
+	"frequency":         "frequencies",
+	"warning_reminder":  "reminders",
+	"critical_reminder": "reminders",
+	"alert_sub_type":    "alert_sub_types",
+}
+
+type enumCatalogueCache struct {
+	FetchedAt  time.Time                  `json:"fetchedAt"`
+	Catalogues map[string][]GenericIdName `json:"catalogues"`
+}
+
+// getEnumCatalogue returns the live catalogue loaded into the provider configuration, or the compiled one
+func getEnumCatalogue(config *Config, name string) map[int]string {
+	if catalogue, ok := config.enumCatalogues[name]; ok {
+		return catalogue
+	}
+	return compiledEnumCatalogues[name]
+}
+
+func getEnumCatalogueSource(config *Config) string {
+	if config.enumCatalogueSource == "" {
+		return "compiled"
+	}
+	return config.enumCatalogueSource
+}
+
+// validateEnumCatalogueDiff checks the catalogue attributes of a test against the catalogues of the provider
+// configuration at plan time, so that values only the live catalogues know are accepted
+func validateEnumCatalogueDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if m == nil {
+		return nil
+	}
+	config := m.(*Config)
+	for _, key := range []string{"monitor", "query_type", "schedule_settings", "alert_settings"} {
+		if err := validateEnumCatalogueValues(config, key, d.Get(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateEnumCatalogueValues walks the value of key and checks every catalogue attribute it holds
+func validateEnumCatalogueValues(config *Config, key string, value interface{}) error {
+	switch value := value.(type) {
+	case *schema.Set:
+		return validateEnumCatalogueValues(config, key, value.List())
+	case []interface{}:
+		for _, item := range value {
+			if err := validateEnumCatalogueValues(config, key, item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for itemKey, item := range value {
+			if err := validateEnumCatalogueValues(config, itemKey, item); err != nil {
+				return err
+			}
+		}
+	case string:
+		catalogueName, ok := enumCatalogueAttributes[key]
+		// Values that are not known yet are empty and are checked once they are
+		if !ok || value == "" {
+			return nil
+		}
+		catalogue := getEnumCatalogue(config, catalogueName)
+		names := make([]string, 0, len(catalogue))
+		for _, name := range catalogue {
+			if name == value {
+				return nil
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return errors.New("invalid " + key + " " + value + " provided. acceptable values are " + strings.Join(names, ", "))
+	}
+	return nil
+}
+
+func getEnumCatalogueNames() []string {
+	names := make([]string, 0, len(compiledEnumCatalogues))
+	for name := range compiledEnumCatalogues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// loadLiveEnumCatalogues replaces the compiled catalogues of the configuration with ones read from a fresh disk
+// cache or from the API. Failures are logged and leave the compiled catalogues in place, so configuring the
+// provider never fails
+func loadLiveEnumCatalogues(config *Config, cacheDir string, cacheTtl time.Duration) {
+	environment := config.Environment
+	if environment == "" {
+		environment = "prod"
+	}
+	cachePath := filepath.Join(cacheDir, "enums-"+environment+".json")
+
+	cache, cacheErr := readEnumCatalogueCache(cachePath)
+	if cacheErr == nil && time.Since(cache.FetchedAt) < cacheTtl {
+		log.Printf("[DEBUG] Using enum catalogues cached at %v", cachePath)
+		setLiveEnumCatalogues(config, cache.Catalogues, "cache")
+		return
+	}
+
+	catalogues, err := getEnumCataloguesFromApi(config.ApiToken)
+	if err != nil {
+		if cacheErr == nil {
+			log.Printf("[WARN] Could not load enum catalogues from the API, using the stale cache at %v: %v", cachePath, err)
+			setLiveEnumCatalogues(config, cache.Catalogues, "stale cache")
+			return
+		}
+		log.Printf("[WARN] Could not load enum catalogues from the API, using the built-in catalogues: %v", err)
+		return
+	}
+	setLiveEnumCatalogues(config, catalogues, "api")
+
+	cacheJson, _ := json.Marshal(enumCatalogueCache{FetchedAt: time.Now(), Catalogues: catalogues})
+	if err := os.MkdirAll(cacheDir, 0700); err != nil {
+		log.Printf("[WARN] Could not create the enum cache directory %v: %v", cacheDir, err)
+		return
+	}
+	if err := os.WriteFile(cachePath, cacheJson, 0600); err != nil {
+		log.Printf("[WARN] Could not write the enum cache %v: %v", cachePath, err)
+	}
+}
+
+func readEnumCatalogueCache(cachePath string) (*enumCatalogueCache, error) {
+	cacheJson, err := os.ReadFile(cachePath)
+	if err != nil {
+		return nil, err
+	}
+	var cache enumCatalogueCache
+	if err := json.Unmarshal(cacheJson, &cache); err != nil {
+		return nil, err
+	}
+	return &cache, nil
+}
+
+func getEnumCataloguesFromApi(apiToken string) (map[string][]GenericIdName, error) {
+
+	type Data struct {
+		Items []GenericIdName `json:"items"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	catalogues := map[string][]GenericIdName{}
+	for name, endpoint := range enumCatalogueEndpoints {
+		var response Response
+		body, responseStatus, err := sendRequest(apiToken, "GET", catchpointApiURI+endpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, errors.New(name + ": " + responseStatus)
+		}
+		json.Unmarshal(body, &response)
+		if !response.Completed {
+			return nil, errors.New(name + ": " + string(body))
+		}
+		catalogues[name] = response.ResponseData.Items
+	}
+	return catalogues, nil
+}
+
+// setLiveEnumCatalogues merges the loaded values into the compiled catalogues. Ids the provider already knows
+// keep their compiled names, since those names are what existing configurations and state use
+func setLiveEnumCatalogues(config *Config, catalogues map[string][]GenericIdName, source string) {
+	merged := map[string]map[int]string{}
+	for name, compiled := range compiledEnumCatalogues {
+		catalogue := map[int]string{}
+		knownNames := map[string]bool{}
+		for id, value := range compiled {
+			catalogue[id] = value
+			knownNames[value] = true
+		}
+		for _, item := range catalogues[name] {
+			value := normalizeEnumName(name, item.Name)
+			// Skip names already in use so that name to id lookups stay unambiguous
+			if _, ok := catalogue[item.Id]; ok || value == "" || knownNames[value] {
+				continue
+			}
+			catalogue[item.Id] = value
+			knownNames[value] = true
+		}
+		merged[name] = catalogue
+	}
+	config.enumCatalogues = merged
+	config.enumCatalogueSource = source
+}
+
+func normalizeEnumName(catalogue string, name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	// Test flag names follow the advanced_settings attribute naming
+	if catalogue == "test_flags" {
+		name = strings.ReplaceAll(name, " ", "_")
+	}
+	return name
+}
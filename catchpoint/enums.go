@@ -13,4 +13,15 @@ const (
 	Ssl         TestType = 18
 	Playwright  TestType = 25
 	Puppeteer   TestType = 26
+	Smtp        TestType = 7
+	Ftp         TestType = 3
+	Tcp         TestType = 4
+	Udp         TestType = 8
+	Ntp         TestType = 14
+	WebSocket   TestType = 15
+	Mqtt        TestType = 17
+	Imap        TestType = 13
+	Pop         TestType = 16
+	Streaming   TestType = 10
+	Custom      TestType = 11
 )
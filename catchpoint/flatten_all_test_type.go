@@ -1,6 +1,7 @@
 package catchpoint
 
 import (
+	"encoding/json"
 	"strings"
 )
 
@@ -70,9 +71,54 @@ func flattenAuthenticationStruct(authentication *AuthenticationStruct) []interfa
 	return nil
 }
 
+func flattenWebSocketScript(requestData string) ([]interface{}, []interface{}) {
+	var script WebSocketScript
+	if err := json.Unmarshal([]byte(requestData), &script); err != nil {
+		return nil, nil
+	}
+	subProtocols := make([]interface{}, len(script.SubProtocols))
+	for i, subProtocol := range script.SubProtocols {
+		subProtocols[i] = subProtocol
+	}
+	messages := make([]interface{}, len(script.Messages))
+	for i, message := range script.Messages {
+		messages[i] = map[string]interface{}{
+			"send":   message.Send,
+			"expect": message.Expect,
+		}
+	}
+	return subProtocols, messages
+}
+
+func flattenCustomScript(requestData string) (string, []interface{}) {
+	var script CustomScript
+	if err := json.Unmarshal([]byte(requestData), &script); err != nil {
+		return "", nil
+	}
+	metrics := make([]interface{}, len(script.Metrics))
+	for i, metric := range script.Metrics {
+		metrics[i] = map[string]interface{}{
+			"name":      metric.Name,
+			"data_type": metric.DataType,
+			"unit":      metric.Unit,
+			"required":  metric.Required,
+		}
+	}
+	return script.Definition, metrics
+}
+
+// flattenBgpSettings returns the prefixes, origin ASNs and upstream neighbors of a bgp test. Tests created with a
+// single prefix have no bgp settings and only carry it in their url
+func flattenBgpSettings(test *Test) ([]string, []int, []int) {
+	if test.BgpSettings == nil || len(test.BgpSettings.Prefixes) == 0 {
+		return []string{test.Url}, nil, nil
+	}
+	return test.BgpSettings.Prefixes, test.BgpSettings.OriginAsns, test.BgpSettings.UpstreamNeighbors
+}
+
 func flattenInsightDataStruct(insightData InsightDataStruct) []interface{} {
 
-	if len(insightData.Indicators) == 0 || len(insightData.Tracepoints) == 0 {
+	if len(insightData.Indicators) == 0 && len(insightData.Tracepoints) == 0 {
 		return nil
 	}
 
@@ -83,11 +129,11 @@ func flattenInsightDataStruct(insightData InsightDataStruct) []interface{} {
 	return []interface{}{insightMap}
 }
 
-func flattenScheduleSetting(scheduleSetting ScheduleSetting) []interface{} {
+func flattenScheduleSetting(config *Config, scheduleSetting ScheduleSetting) []interface{} {
 	scheduleMap := map[string]interface{}{
 		"run_schedule_id":         scheduleSetting.RunScheduleId,
 		"maintenance_schedule_id": scheduleSetting.MaintenanceScheduleId,
-		"frequency":               getFrequencyName(scheduleSetting.Frequency.Id),
+		"frequency":               getFrequencyName(config, scheduleSetting.Frequency.Id),
 		"node_distribution":       getNodeDistributionName(scheduleSetting.TestNodeDistribution.Id),
 		"no_of_subset_nodes":      scheduleSetting.NoOfSubsetNodes,
 		//Backbone network type is currently supported. Remove comment if more types are added
@@ -160,6 +206,18 @@ func flattenAdvancedSetting(advancedSetting AdvancedSetting) []interface{} {
 		advSettingMap["edns_subnet"] = advancedSetting.EdnsSubnet
 	}
 
+	if advancedSetting.Bitrate != 0 {
+		advSettingMap["bitrate"] = advancedSetting.Bitrate
+	}
+
+	if advancedSetting.PlaybackDuration != 0 {
+		advSettingMap["playback_duration"] = advancedSetting.PlaybackDuration
+	}
+
+	if advancedSetting.StartupTimeThreshold != 0 {
+		advSettingMap["startup_time_threshold"] = advancedSetting.StartupTimeThreshold
+	}
+
 	for _, flag := range advancedSetting.AppliedTestFlags {
 		lowerCasedSpaceReplacedFlagName := getTestFlagName(flag.Id)
 		if lowerCasedSpaceReplacedFlagName != "" {
@@ -276,7 +334,7 @@ func flattenAlertRuleNotificationGroup(notificationGroups []NotificationGroupStr
 	return flattenedGroups
 }
 
-func flattenAlertGroupItem(alertGroupItem AlertGroupItem) map[string]interface{} {
+func flattenAlertGroupItem(config *Config, alertGroupItem AlertGroupItem) map[string]interface{} {
 	nodeThreshold := alertGroupItem.NodeThreshold
 	trigger := alertGroupItem.Trigger
 	alertGroupItemMap := map[string]interface{}{
@@ -291,8 +349,8 @@ func flattenAlertGroupItem(alertGroupItem AlertGroupItem) map[string]interface{}
 		"threshold_percentage_of_runs": nodeThreshold.PercentageOfUnits,
 		"number_of_failing_nodes":      nodeThreshold.NumberOfFailingUnits,
 		"enable_consecutive":           nodeThreshold.ConsecutiveRunsEnabled,
-		"warning_reminder":             getReminderName(trigger.WarningReminderFrequency.Id),
-		"critical_reminder":            getReminderName(trigger.CriticalReminderFrequency.Id),
+		"warning_reminder":             getReminderName(config, trigger.WarningReminderFrequency.Id),
+		"critical_reminder":            getReminderName(config, trigger.CriticalReminderFrequency.Id),
 		"trigger_type":                 getTriggerTypeName(trigger.TriggerType.Id),
 		"operation_type":               getOperationTypeName(trigger.OperationType.Id),
 		"threshold_interval":           getThresholdIntervalName(trigger.ThresholdInterval.Id),
@@ -307,7 +365,7 @@ func flattenAlertGroupItem(alertGroupItem AlertGroupItem) map[string]interface{}
 	}
 
 	if alertGroupItem.AlertSubType != nil {
-		alertGroupItemMap["alert_sub_type"] = getAlertSubTypeName(alertGroupItem.AlertSubType.Id)
+		alertGroupItemMap["alert_sub_type"] = getAlertSubTypeName(config, alertGroupItem.AlertSubType.Id)
 	}
 	if trigger.StatisticalType != nil {
 		alertGroupItemMap["statistical_type"] = strings.ToLower(trigger.StatisticalType.Name)
@@ -319,10 +377,10 @@ func flattenAlertGroupItem(alertGroupItem AlertGroupItem) map[string]interface{}
 	return alertGroupItemMap
 }
 
-func flattenAlertGroupStruct(alertGroup AlertGroupStruct) []interface{} {
+func flattenAlertGroupStruct(config *Config, alertGroup AlertGroupStruct) []interface{} {
 	alertGroupItems := make([]interface{}, len(alertGroup.AlertGroupItems))
 	for i, item := range alertGroup.AlertGroupItems {
-		alertGroupItems[i] = flattenAlertGroupItem(item)
+		alertGroupItems[i] = flattenAlertGroupItem(config, item)
 	}
 
 	alertGroupMap := map[string]interface{}{
@@ -332,12 +390,12 @@ func flattenAlertGroupStruct(alertGroup AlertGroupStruct) []interface{} {
 	return []interface{}{alertGroupMap}
 }
 
-func flattenTest(test *Test) map[string]interface{} {
+func flattenTest(config *Config, test *Test) map[string]interface{} {
 	dnsQueryType := ""
 	userAgentType := ""
 	chromeVersion := ""
 	if test.DnsQueryType != nil {
-		dnsQueryType = getDnsQueryName(test.DnsQueryType.Id)
+		dnsQueryType = getDnsQueryName(config, test.DnsQueryType.Id)
 	}
 	if test.UserAgentType != nil {
 		userAgentType = getUserAgentTypeName(test.UserAgentType.Id)
@@ -364,18 +422,44 @@ func flattenTest(test *Test) map[string]interface{} {
 		"start_time":                      test.StartTime,
 		"end_time":                        test.EndTime,
 		"status":                          strings.ToLower(test.Status.Name),
-		"monitor":                         getMonitorName(test.Monitor.Id),
+		"monitor":                         getMonitorName(config, test.Monitor.Id),
 		"dns_server":                      test.DnsServer,
+		"payload":                         test.Payload,
+		"expected_response":               test.ExpectedResponse,
+		"offset_threshold":                test.NtpOffsetThreshold,
 		"query_type":                      dnsQueryType,
 		"user_agent_type":                 userAgentType,
 		"chrome_version":                  chromeVersion,
 		"request_settings":                flattenRequestSetting(test.RequestSettings),
-		"alert_settings":                  flattenAlertGroupStruct(test.AlertGroup),
+		"alert_settings":                  flattenAlertGroupStruct(config, test.AlertGroup),
 		"insights":                        flattenInsightDataStruct(test.InsightData),
-		"schedule_settings":               flattenScheduleSetting(test.ScheduleSettings),
+		"schedule_settings":               flattenScheduleSetting(config, test.ScheduleSettings),
 		"advanced_settings":               flattenAdvancedSetting(test.AdvancedSettings),
 	}
 
+	if test.EncryptionType != nil {
+		testMap["tls_mode"] = getEncryptionTypeName(test.EncryptionType.Id)
+	}
+	if test.FtpOperationType != nil {
+		testMap["operation"] = getFtpOperationTypeName(test.FtpOperationType.Id)
+		testMap["expected_file_size"] = test.ExpectedFileSize
+	}
+	if test.TestType.Id == int(TestType(Mqtt)) {
+		testMap["topic"] = test.MqttTopic
+		testMap["qos"] = test.MqttQos
+	}
+	if test.TestType.Id == int(TestType(Imap)) {
+		testMap["mailbox"] = test.Mailbox
+	}
+	if test.TestType.Id == int(TestType(Bgp)) {
+		testMap["prefixes"], testMap["expected_origin_asns"], testMap["expected_upstream_neighbors"] = flattenBgpSettings(test)
+	}
+	if test.TestRequestData != nil && test.TestType.Id == int(TestType(WebSocket)) {
+		testMap["sub_protocols"], testMap["message"] = flattenWebSocketScript(test.TestRequestData.RequestData)
+	}
+	if test.TestRequestData != nil && test.TestType.Id == int(TestType(Custom)) {
+		testMap["definition"], testMap["metric"] = flattenCustomScript(test.TestRequestData.RequestData)
+	}
 	if test.TestRequestData != nil {
 		if test.TestRequestData.RequestData != "" {
 			testMap["test_script"] = test.TestRequestData.RequestData
@@ -0,0 +1,91 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const foldersPageSize = 100
+
+// Folder carries the settings the tests and subfolders of the folder inherit. ParentId is 0 for folders
+// directly under a product
+type Folder struct {
+	Id               int               `json:"id"`
+	Name             string            `json:"name"`
+	DivisionId       int               `json:"divisionId"`
+	ProductId        int               `json:"productId"`
+	ParentId         int               `json:"parentId"`
+	RequestSettings  RequestSetting    `json:"requestSettings"`
+	AlertGroup       AlertGroupStruct  `json:"alertGroup"`
+	InsightData      InsightDataStruct `json:"insightData"`
+	ScheduleSettings ScheduleSetting   `json:"scheduleSettings"`
+	AdvancedSettings AdvancedSetting   `json:"advancedSettings"`
+}
+
+// getFolders pages through the folders of a division
+func getFolders(apiToken string, divisionId int) ([]Folder, string, error) {
+
+	type Data struct {
+		Folders []Folder `json:"folders"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var folders []Folder
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointApiURI + "/folders?divisionId=" + strconv.Itoa(divisionId) + "&pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(foldersPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		folders = append(folders, response.ResponseData.Folders...)
+		if len(response.ResponseData.Folders) < foldersPageSize {
+			break
+		}
+	}
+
+	return folders, responseStatus, nil
+}
+
+// getFolder reads a folder with its effective settings, including the ones inherited from parent folders,
+// the product and the division
+func getFolder(apiToken string, folderId int) (*Folder, string, error) {
+
+	type Data struct {
+		Folders []Folder `json:"folders"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/folders/" + strconv.Itoa(folderId) + "?showInheritedProperties=true"
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return nil, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Folder not found
+	if !response.Completed || len(response.ResponseData.Folders) == 0 {
+		return nil, responseStatus, nil
+	}
+	folder := response.ResponseData.Folders[0]
+
+	return &folder, responseStatus, nil
+}
@@ -1,8 +1,14 @@
 package catchpoint
 
 import (
+	"errors"
 	"math/rand"
+	"net"
+	"net/url"
 	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
@@ -10,6 +16,14 @@ type Config struct {
 	ApiToken    string
 	LogJson     bool
 	Environment string
+
+	// Chrome versions fetched from the API, cached for the lifetime of the provider instance
+	chromeVersions      []ChromeVersion
+	chromeVersionsMutex sync.Mutex
+
+	// Enum catalogues loaded at configure time when live_enums is enabled. Nil means the compiled ones are used
+	enumCatalogues      map[string]map[int]string
+	enumCatalogueSource string
 }
 
 func newConfig(apiToken string, logJson bool, cpEnvironment string) *Config {
@@ -49,29 +63,42 @@ func getTestStatusTypeId(testStatus string) int {
 	return 0
 }
 
-func getMonitorId(monitor string) int {
-	monitorTypes := map[int]string{
-		2:  "object",
-		3:  "emulated",
-		18: "chrome",
-		19: "playback",
-		20: "mobile playback",
-		26: "mobile",
-		25: "api",
-		8:  "ping icmp",
-		11: "ping tcp",
-		12: "dns experience",
-		13: "dns direct",
-		23: "ping udp",
-		9:  "traceroute icmp",
-		14: "traceroute udp",
-		29: "traceroute tcp",
-		31: "ssl",
-		34: "bgp",
-		39: "playwright",
-		41: "bgp basic",
-	}
-	for id, monitorType := range monitorTypes {
+var monitorTypes = map[int]string{
+	2:  "object",
+	3:  "emulated",
+	18: "chrome",
+	19: "playback",
+	20: "mobile playback",
+	26: "mobile",
+	25: "api",
+	8:  "ping icmp",
+	11: "ping tcp",
+	12: "dns experience",
+	13: "dns direct",
+	23: "ping udp",
+	9:  "traceroute icmp",
+	14: "traceroute udp",
+	29: "traceroute tcp",
+	31: "ssl",
+	34: "bgp",
+	39: "playwright",
+	41: "bgp basic",
+	5:  "smtp",
+	4:  "ftp",
+	6:  "tcp",
+	7:  "udp",
+	16: "ntp",
+	17: "websocket",
+	22: "mqtt",
+	15: "imap",
+	21: "pop",
+	24: "streaming",
+	27: "custom",
+	38: "puppeteer",
+}
+
+func getMonitorId(config *Config, monitor string) int {
+	for id, monitorType := range getEnumCatalogue(config, "monitors") {
 		if monitorType == monitor {
 			return id
 		}
@@ -79,29 +106,8 @@ func getMonitorId(monitor string) int {
 	return -1
 }
 
-func getMonitorName(monitor int) string {
-	monitorTypes := map[int]string{
-		2:  "object",
-		3:  "emulated",
-		18: "chrome",
-		19: "playback",
-		20: "mobile playback",
-		26: "mobile",
-		25: "api",
-		8:  "ping icmp",
-		11: "ping tcp",
-		12: "dns experience",
-		13: "dns direct",
-		23: "ping udp",
-		9:  "traceroute icmp",
-		14: "traceroute udp",
-		29: "traceroute tcp",
-		31: "ssl",
-		34: "bgp",
-		39: "playwright",
-		41: "bgp basic",
-	}
-	for id, monitorType := range monitorTypes {
+func getMonitorName(config *Config, monitor int) string {
+	for id, monitorType := range getEnumCatalogue(config, "monitors") {
 		if id == monitor {
 			return monitorType
 		}
@@ -115,6 +121,8 @@ func getApiScriptTypeId(scriptType string) int {
 		2: "javascript",
 		3: "playwright",
 		4: "puppeteer",
+		5: "websocket",
+		6: "custom",
 	}
 	for id, apiScriptType := range apiScriptTypes {
 		if apiScriptType == scriptType {
@@ -193,109 +201,117 @@ func getUserAgentTypeName(userAgentType int) string {
 }
 
 func getChromeVersionId(chromeVersion string) (int, string) {
-	chromeVersions := map[int][]string{
-		1: {"stable"},
-		2: {"preview"},
-		3: {"108", "89", "87", "85", "75", "71", "66", "63", "59", "53"},
-	}
-	for id, chromeVer := range chromeVersions {
-		for _, specifcVersion := range chromeVer {
-			if specifcVersion == chromeVersion {
-				return id, specifcVersion
-			}
+	switch chromeVersion {
+	case "":
+		return 0, ""
+	case "stable":
+		return 1, chromeVersion
+	case "preview":
+		return 2, chromeVersion
+	}
+	// Any other value is a specific version from the Chrome version catalogue
+	return 3, chromeVersion
+}
+
+// defaultChromeVersions is used when the Chrome version catalogue cannot be fetched from the API
+var defaultChromeVersions = []ChromeVersion{
+	{Id: 1, Name: "53"},
+	{Id: 3, Name: "59"},
+	{Id: 4, Name: "63"},
+	{Id: 5, Name: "66"},
+	{Id: 7, Name: "75"},
+	{Id: 8, Name: "71"},
+	{Id: 12, Name: "85"},
+	{Id: 13, Name: "87"},
+	{Id: 14, Name: "89"},
+	{Id: 28558, Name: "108"},
+}
+
+func getChromeApplicationVersionId(chromeVersions []ChromeVersion, chromeApplicationVersion string) (int, string) {
+	for _, chromeVersion := range chromeVersions {
+		if chromeVersion.Name == chromeApplicationVersion {
+			return chromeVersion.Id, chromeVersion.Name
 		}
 	}
 	return 0, ""
 }
 
-func getChromeApplicationVersionId(chromeApplicationVersion string) (int, string) {
-	chromeApplicationVersions := map[int]string{
-		1:     "53",
-		3:     "59",
-		4:     "63",
-		5:     "66",
-		7:     "75",
-		8:     "71",
-		12:    "85",
-		13:    "87",
-		14:    "89",
-		28558: "108",
-	}
-	for id, chromeApplicationVer := range chromeApplicationVersions {
-
-		if chromeApplicationVer == chromeApplicationVersion {
-			return id, chromeApplicationVer
+func getChromeApplicationVersionName(chromeVersions []ChromeVersion, chromeApplicationVersion int) string {
+	for _, chromeVersion := range chromeVersions {
+		if chromeVersion.Id == chromeApplicationVersion {
+			return chromeVersion.Name
 		}
 	}
-	return 0, ""
+	return ""
+}
+
+var queryTypes = map[int]string{
+	0:     "none",
+	1:     "a",
+	2:     "ns",
+	5:     "cname",
+	6:     "soa",
+	7:     "mb",
+	8:     "mg",
+	9:     "mr",
+	10:    "null",
+	11:    "wks",
+	12:    "ptr",
+	13:    "hinfo",
+	14:    "minfo",
+	15:    "mx",
+	16:    "txt",
+	17:    "rp",
+	18:    "afsdb",
+	19:    "x25",
+	20:    "isdn",
+	21:    "rt",
+	22:    "nsap",
+	24:    "sig",
+	25:    "key",
+	26:    "px",
+	28:    "aaaa",
+	29:    "loc",
+	31:    "eid",
+	32:    "nimloc",
+	33:    "srv",
+	34:    "atma",
+	35:    "naptr",
+	36:    "kx",
+	37:    "cert",
+	38:    "a6",
+	39:    "dname",
+	40:    "sink",
+	41:    "opt",
+	42:    "apl",
+	43:    "ds",
+	44:    "sshfp",
+	45:    "ipseckey",
+	46:    "rrsig",
+	47:    "nsec",
+	48:    "dnskey",
+	49:    "dhcid",
+	50:    "nsec3",
+	51:    "nsec3param",
+	55:    "hip",
+	99:    "spf",
+	100:   "uinfo",
+	101:   "uid",
+	102:   "gid",
+	103:   "unspec",
+	249:   "tkey",
+	250:   "tsig",
+	251:   "ixfr",
+	252:   "axfr",
+	253:   "mailb",
+	255:   "any",
+	32768: "ta",
+	32769: "dlv",
+	32770: "AorAAAA",
 }
 
-func getDnsQueryTypeId(queryType string) (int, string) {
-	queryTypes := map[int]string{
-		0:     "none",
-		1:     "a",
-		2:     "ns",
-		5:     "cname",
-		6:     "soa",
-		7:     "mb",
-		8:     "mg",
-		9:     "mr",
-		10:    "null",
-		11:    "wks",
-		12:    "ptr",
-		13:    "hinfo",
-		14:    "minfo",
-		15:    "mx",
-		16:    "txt",
-		17:    "rp",
-		18:    "afsdb",
-		19:    "x25",
-		20:    "isdn",
-		21:    "rt",
-		22:    "nsap",
-		24:    "sig",
-		25:    "key",
-		26:    "px",
-		28:    "aaaa",
-		29:    "loc",
-		31:    "eid",
-		32:    "nimloc",
-		33:    "srv",
-		34:    "atma",
-		35:    "naptr",
-		36:    "kx",
-		37:    "cert",
-		38:    "a6",
-		39:    "dname",
-		40:    "sink",
-		41:    "opt",
-		42:    "apl",
-		43:    "ds",
-		44:    "sshfp",
-		45:    "ipseckey",
-		46:    "rrsig",
-		47:    "nsec",
-		48:    "dnskey",
-		49:    "dhcid",
-		50:    "nsec3",
-		51:    "nsec3param",
-		55:    "hip",
-		99:    "spf",
-		100:   "uinfo",
-		101:   "uid",
-		102:   "gid",
-		103:   "unspec",
-		249:   "tkey",
-		250:   "tsig",
-		251:   "ixfr",
-		252:   "axfr",
-		253:   "mailb",
-		255:   "any",
-		32768: "ta",
-		32769: "dlv",
-		32770: "AorAAAA",
-	}
-	for id, queryTypeString := range queryTypes {
+func getDnsQueryTypeId(config *Config, queryType string) (int, string) {
+	for id, queryTypeString := range getEnumCatalogue(config, "dns_query_types") {
 		if queryTypeString == queryType {
 			return id, queryTypeString
 		}
@@ -303,72 +319,8 @@ func getDnsQueryTypeId(queryType string) (int, string) {
 	return 0, ""
 }
 
-func getDnsQueryName(queryType int) string {
-	queryTypes := map[int]string{
-		0:     "none",
-		1:     "a",
-		2:     "ns",
-		5:     "cname",
-		6:     "soa",
-		7:     "mb",
-		8:     "mg",
-		9:     "mr",
-		10:    "null",
-		11:    "wks",
-		12:    "ptr",
-		13:    "hinfo",
-		14:    "minfo",
-		15:    "mx",
-		16:    "txt",
-		17:    "rp",
-		18:    "afsdb",
-		19:    "x25",
-		20:    "isdn",
-		21:    "rt",
-		22:    "nsap",
-		24:    "sig",
-		25:    "key",
-		26:    "px",
-		28:    "aaaa",
-		29:    "loc",
-		31:    "eid",
-		32:    "nimloc",
-		33:    "srv",
-		34:    "atma",
-		35:    "naptr",
-		36:    "kx",
-		37:    "cert",
-		38:    "a6",
-		39:    "dname",
-		40:    "sink",
-		41:    "opt",
-		42:    "apl",
-		43:    "ds",
-		44:    "sshfp",
-		45:    "ipseckey",
-		46:    "rrsig",
-		47:    "nsec",
-		48:    "dnskey",
-		49:    "dhcid",
-		50:    "nsec3",
-		51:    "nsec3param",
-		55:    "hip",
-		99:    "spf",
-		100:   "uinfo",
-		101:   "uid",
-		102:   "gid",
-		103:   "unspec",
-		249:   "tkey",
-		250:   "tsig",
-		251:   "ixfr",
-		252:   "axfr",
-		253:   "mailb",
-		255:   "any",
-		32768: "ta",
-		32769: "dlv",
-		32770: "AorAAAA",
-	}
-	for id, queryTypeString := range queryTypes {
+func getDnsQueryName(config *Config, queryType int) string {
+	for id, queryTypeString := range getEnumCatalogue(config, "dns_query_types") {
 		if id == queryType {
 			return queryTypeString
 		}
@@ -376,27 +328,28 @@ func getDnsQueryName(queryType int) string {
 	return ""
 }
 
-func getFrequencyId(frequency string) (int, string) {
-	frequencies := map[int]string{
-		0:  "none",
-		1:  "1 minute",
-		2:  "5 minutes",
-		3:  "10 minutes",
-		4:  "15 minutes",
-		5:  "20 minutes",
-		6:  "30 minutes",
-		7:  "60 minutes",
-		8:  "2 hours",
-		9:  "3 hours",
-		10: "4 hours",
-		11: "6 hours",
-		12: "8 hours",
-		13: "12 hours",
-		14: "24 hours",
-		15: "4 minutes",
-		16: "2 minutes",
-	}
-	for id, freq := range frequencies {
+var frequencies = map[int]string{
+	0:  "none",
+	1:  "1 minute",
+	2:  "5 minutes",
+	3:  "10 minutes",
+	4:  "15 minutes",
+	5:  "20 minutes",
+	6:  "30 minutes",
+	7:  "60 minutes",
+	8:  "2 hours",
+	9:  "3 hours",
+	10: "4 hours",
+	11: "6 hours",
+	12: "8 hours",
+	13: "12 hours",
+	14: "24 hours",
+	15: "4 minutes",
+	16: "2 minutes",
+}
+
+func getFrequencyId(config *Config, frequency string) (int, string) {
+	for id, freq := range getEnumCatalogue(config, "frequencies") {
 		if freq == frequency {
 			return id, freq
 		}
@@ -404,27 +357,8 @@ func getFrequencyId(frequency string) (int, string) {
 	return -1, ""
 }
 
-func getFrequencyName(frequency int) string {
-	frequencies := map[int]string{
-		0:  "none",
-		1:  "1 minute",
-		2:  "5 minutes",
-		3:  "10 minutes",
-		4:  "15 minutes",
-		5:  "20 minutes",
-		6:  "30 minutes",
-		7:  "60 minutes",
-		8:  "2 hours",
-		9:  "3 hours",
-		10: "4 hours",
-		11: "6 hours",
-		12: "8 hours",
-		13: "12 hours",
-		14: "24 hours",
-		15: "4 minutes",
-		16: "2 minutes",
-	}
-	for id, freq := range frequencies {
+func getFrequencyName(config *Config, frequency int) string {
+	for id, freq := range getEnumCatalogue(config, "frequencies") {
 		if id == frequency {
 			return freq
 		}
@@ -549,18 +483,19 @@ func getTriggerTypeName(triggerType int) string {
 	return "specific value"
 }
 
-func getReminderId(reminder string) (int, string) {
-	reminders := map[int]string{
-		0:    "none",
-		1:    "1 minute",
-		5:    "5 minutes",
-		10:   "10 minutes",
-		15:   "15 minutes",
-		30:   "30 minutes",
-		60:   "1 hour",
-		1440: "daily",
-	}
-	for id, reminderInterval := range reminders {
+var reminders = map[int]string{
+	0:    "none",
+	1:    "1 minute",
+	5:    "5 minutes",
+	10:   "10 minutes",
+	15:   "15 minutes",
+	30:   "30 minutes",
+	60:   "1 hour",
+	1440: "daily",
+}
+
+func getReminderId(config *Config, reminder string) (int, string) {
+	for id, reminderInterval := range getEnumCatalogue(config, "reminders") {
 		if reminderInterval == reminder {
 			return id, reminderInterval
 		}
@@ -568,18 +503,8 @@ func getReminderId(reminder string) (int, string) {
 	return 0, "none"
 }
 
-func getReminderName(reminder int) string {
-	reminders := map[int]string{
-		0:    "none",
-		1:    "1 minute",
-		5:    "5 minutes",
-		10:   "10 minutes",
-		15:   "15 minutes",
-		30:   "30 minutes",
-		60:   "1 hour",
-		1440: "daily",
-	}
-	for id, reminderInterval := range reminders {
+func getReminderName(config *Config, reminder int) string {
+	for id, reminderInterval := range getEnumCatalogue(config, "reminders") {
 		if id == reminder {
 			return reminderInterval
 		}
@@ -723,57 +648,61 @@ func getAlertTypeName(alertType int) string {
 	return ""
 }
 
-func getAlertSubTypeId(alertSubType string) (int, string) {
-	alertSubTypes := map[int]string{
-		1:   "byte length",
-		2:   "page",
-		3:   "file size",
-		10:  "regular expression",
-		14:  "response code",
-		15:  "response headers",
-		50:  "dns",
-		51:  "connect",
-		52:  "send",
-		53:  "wait",
-		54:  "load",
-		55:  "ttfb",
-		57:  "content load",
-		58:  "response",
-		59:  "test time",
-		61:  "dom load",
-		63:  "test time with suspect",
-		64:  "server response",
-		66:  "document complete",
-		67:  "redirect",
-		100: "ping rtt",
-		101: "ping packet loss",
-		110: "# requests",
-		111: "# hosts",
-		112: "# connections",
-		113: "# redirects",
-		114: "# other",
-		115: "# images",
-		116: "# scripts",
-		117: "# html",
-		118: "# css",
-		119: "# xml",
-		120: "# flash",
-		121: "# media",
-		140: "test",
-		141: "content",
-		142: "% downtime",
-		190: "# cities",
-		191: "# asns",
-		193: "# countries",
-		194: "# hops",
-		195: "handshake_time",
-		196: "days_to_expiration",
-		210: "origin as",
-		211: "path as",
-		212: "origin neighbor",
-		213: "prefix mismatch",
-	}
-	for id, alertSubTypeString := range alertSubTypes {
+var alertSubTypes = map[int]string{
+	1:   "byte length",
+	2:   "page",
+	3:   "file size",
+	10:  "regular expression",
+	14:  "response code",
+	15:  "response headers",
+	50:  "dns",
+	51:  "connect",
+	52:  "send",
+	53:  "wait",
+	54:  "load",
+	55:  "ttfb",
+	57:  "content load",
+	58:  "response",
+	59:  "test time",
+	61:  "dom load",
+	63:  "test time with suspect",
+	64:  "server response",
+	66:  "document complete",
+	67:  "redirect",
+	100: "ping rtt",
+	101: "ping packet loss",
+	110: "# requests",
+	111: "# hosts",
+	112: "# connections",
+	113: "# redirects",
+	114: "# other",
+	115: "# images",
+	116: "# scripts",
+	117: "# html",
+	118: "# css",
+	119: "# xml",
+	120: "# flash",
+	121: "# media",
+	140: "test",
+	141: "content",
+	142: "% downtime",
+	190: "# cities",
+	191: "# asns",
+	193: "# countries",
+	194: "# hops",
+	195: "handshake_time",
+	196: "days_to_expiration",
+	210: "origin as",
+	211: "path as",
+	212: "origin neighbor",
+	213: "prefix mismatch",
+	220: "offset",
+	221: "startup time",
+	222: "buffering time",
+}
+
+func getAlertSubTypeId(config *Config, alertSubType string) (int, string) {
+	for id, alertSubTypeString := range getEnumCatalogue(config, "alert_sub_types") {
 		if alertSubTypeString == alertSubType {
 			return id, alertSubTypeString
 		}
@@ -781,57 +710,8 @@ func getAlertSubTypeId(alertSubType string) (int, string) {
 	return -1, ""
 }
 
-func getAlertSubTypeName(alertSubType int) string {
-	alertSubTypes := map[int]string{
-		1:   "byte length",
-		2:   "page",
-		3:   "file size",
-		10:  "regular expression",
-		14:  "response code",
-		15:  "response headers",
-		50:  "dns",
-		51:  "connect",
-		52:  "send",
-		53:  "wait",
-		54:  "load",
-		55:  "ttfb",
-		57:  "content load",
-		58:  "response",
-		59:  "test time",
-		61:  "dom load",
-		63:  "test time with suspect",
-		64:  "server response",
-		66:  "document complete",
-		67:  "redirect",
-		100: "ping rtt",
-		101: "ping packet loss",
-		110: "# requests",
-		111: "# hosts",
-		112: "# connections",
-		113: "# redirects",
-		114: "# other",
-		115: "# images",
-		116: "# scripts",
-		117: "# html",
-		118: "# css",
-		119: "# xml",
-		120: "# flash",
-		121: "# media",
-		140: "test",
-		141: "content",
-		142: "% downtime",
-		190: "# cities",
-		191: "# asns",
-		193: "# countries",
-		194: "# hops",
-		195: "handshake_time",
-		196: "days_to_expiration",
-		210: "origin as",
-		211: "path as",
-		212: "origin neighbor",
-		213: "prefix mismatch",
-	}
-	for id, alertSubTypeString := range alertSubTypes {
+func getAlertSubTypeName(config *Config, alertSubType int) string {
+	for id, alertSubTypeString := range getEnumCatalogue(config, "alert_sub_types") {
 		if id == alertSubType {
 			return alertSubTypeString
 		}
@@ -851,37 +731,38 @@ func getStatisticalTypeId(statisticalType string) (int, string) {
 	return 1, "average"
 }
 
-func getTestFlagId(testFlag string) int {
-	testFlagTypes := map[int]string{
-		2:  "verify_test_on_failure",
-		3:  "debug_primary_host_on_failure",
-		4:  "enable_http2",
-		8:  "debug_referenced_hosts_on_failure",
-		9:  "capture_http_headers",
-		11: "capture_response_content",
-		13: "capture_filmstrip",
-		14: "capture_screenshot",
-		17: "ignore_ssl_failures",
-		19: "enable_bind_hostname",
-		20: "enable_tcp_protocol",
-		21: "enable_nsid",
-		22: "disable_recursive_resolution",
-		23: "host_data_collection_enabled",
-		24: "zone_data_collection_enabled",
-		25: "stop_test_on_document_complete",
-		26: "try_next_nameserver_on_failure",
-		27: "f40x_or_50x_http_mark_successful",
-		31: "favor_fastest_round_trip_nameserver",
-		33: "t30x_redirects_do_not_follow",
-		36: "enable_self_versus_third_party_zones",
-		37: "allow_test_download_limit_override",
-		38: "disable_cross_origin_iframe_access",
-		39: "stop_test_on_dom_content_load",
-		42: "certificate_revocation_disabled",
-		48: "enable_dnssec",
-		50: "enable_path_mtu_discovery",
-	}
-	for id, testFlagString := range testFlagTypes {
+var testFlagTypes = map[int]string{
+	2:  "verify_test_on_failure",
+	3:  "debug_primary_host_on_failure",
+	4:  "enable_http2",
+	8:  "debug_referenced_hosts_on_failure",
+	9:  "capture_http_headers",
+	11: "capture_response_content",
+	13: "capture_filmstrip",
+	14: "capture_screenshot",
+	17: "ignore_ssl_failures",
+	19: "enable_bind_hostname",
+	20: "enable_tcp_protocol",
+	21: "enable_nsid",
+	22: "disable_recursive_resolution",
+	23: "host_data_collection_enabled",
+	24: "zone_data_collection_enabled",
+	25: "stop_test_on_document_complete",
+	26: "try_next_nameserver_on_failure",
+	27: "f40x_or_50x_http_mark_successful",
+	31: "favor_fastest_round_trip_nameserver",
+	33: "t30x_redirects_do_not_follow",
+	36: "enable_self_versus_third_party_zones",
+	37: "allow_test_download_limit_override",
+	38: "disable_cross_origin_iframe_access",
+	39: "stop_test_on_dom_content_load",
+	42: "certificate_revocation_disabled",
+	48: "enable_dnssec",
+	50: "enable_path_mtu_discovery",
+}
+
+func getTestFlagId(config *Config, testFlag string) int {
+	for id, testFlagString := range getEnumCatalogue(config, "test_flags") {
 		if testFlagString == testFlag {
 			return id
 		}
@@ -890,36 +771,8 @@ func getTestFlagId(testFlag string) int {
 
 }
 
+// getTestFlagName only uses the compiled flags, since every flag it returns must be an advanced_settings attribute
 func getTestFlagName(testFlag int) string {
-	testFlagTypes := map[int]string{
-		2:  "verify_test_on_failure",
-		3:  "debug_primary_host_on_failure",
-		4:  "enable_http2",
-		8:  "debug_referenced_hosts_on_failure",
-		9:  "capture_http_headers",
-		11: "capture_response_content",
-		13: "capture_filmstrip",
-		14: "capture_screenshot",
-		17: "ignore_ssl_failures",
-		19: "enable_bind_hostname",
-		20: "enable_tcp_protocol",
-		21: "enable_nsid",
-		22: "disable_recursive_resolution",
-		23: "host_data_collection_enabled",
-		24: "zone_data_collection_enabled",
-		25: "stop_test_on_document_complete",
-		26: "try_next_nameserver_on_failure",
-		27: "f40x_or_50x_http_mark_successful",
-		31: "favor_fastest_round_trip_nameserver",
-		33: "t30x_redirects_do_not_follow",
-		36: "enable_self_versus_third_party_zones",
-		37: "allow_test_download_limit_override",
-		38: "disable_cross_origin_iframe_access",
-		39: "stop_test_on_dom_content_load",
-		42: "certificate_revocation_disabled",
-		48: "enable_dnssec",
-		50: "enable_path_mtu_discovery",
-	}
 	for id, testFlagString := range testFlagTypes {
 		if id == testFlag {
 			return testFlagString
@@ -1065,6 +918,116 @@ func getAuthenticationTypeId(authenticationType string) (int, string) {
 	return -1, ""
 }
 
+func getEncryptionTypeId(encryptionType string) (int, string) {
+	encryptionTypes := map[int]string{
+		0: "none",
+		1: "starttls",
+		2: "tls",
+	}
+	for id, encryptionTypeString := range encryptionTypes {
+		if encryptionTypeString == encryptionType {
+			return id, encryptionTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getEncryptionTypeName(encryptionType int) string {
+	encryptionTypes := map[int]string{
+		0: "none",
+		1: "starttls",
+		2: "tls",
+	}
+	for id, encryptionTypeString := range encryptionTypes {
+		if id == encryptionType {
+			return encryptionTypeString
+		}
+	}
+	return ""
+}
+
+func getFtpOperationTypeId(ftpOperationType string) (int, string) {
+	ftpOperationTypes := map[int]string{
+		0: "list",
+		1: "download",
+		2: "upload",
+	}
+	for id, ftpOperationTypeString := range ftpOperationTypes {
+		if ftpOperationTypeString == ftpOperationType {
+			return id, ftpOperationTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getFtpOperationTypeName(ftpOperationType int) string {
+	ftpOperationTypes := map[int]string{
+		0: "list",
+		1: "download",
+		2: "upload",
+	}
+	for id, ftpOperationTypeString := range ftpOperationTypes {
+		if id == ftpOperationType {
+			return ftpOperationTypeString
+		}
+	}
+	return ""
+}
+
+// getTestUrl builds the url of a test that connects to a host and port, like smtp://mail.domain.com:25
+func getTestUrl(scheme string, host string, port int) string {
+	return scheme + "://" + net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// getTestUrlHostPort splits a url built by getTestUrl back into its host and port
+func getTestUrlHostPort(testUrl string) (string, int) {
+	parsedUrl, err := url.Parse(testUrl)
+	if err != nil {
+		return testUrl, 0
+	}
+	port, _ := strconv.Atoi(parsedUrl.Port())
+	return parsedUrl.Hostname(), port
+}
+
+// validateBgpPrefix accepts IPv4 prefixes with a netmask from 8 to 24 and IPv6 prefixes with a netmask from 28 to 128
+func validateBgpPrefix(i interface{}, k string) ([]string, []error) {
+	prefix, ok := i.(string)
+	if !ok {
+		return nil, []error{errors.New("expected type of " + k + " to be string")}
+	}
+	_, ipNet, err := net.ParseCIDR(prefix)
+	if err != nil {
+		return nil, []error{errors.New(k + " must be a prefix in CIDR notation like 192.0.2.0/24, got " + prefix)}
+	}
+	netmask, bits := ipNet.Mask.Size()
+	if bits == 32 && (netmask < 8 || netmask > 24) {
+		return nil, []error{errors.New(k + " must be an IPV4 prefix with a netmask from 8 to 24, got " + prefix)}
+	}
+	if bits == 128 && netmask < 28 {
+		return nil, []error{errors.New(k + " must be an IPV6 prefix with a netmask from 28 to 128, got " + prefix)}
+	}
+	return nil, nil
+}
+
+// getBgpAlertExpression returns the expression an ASN alert rule of a bgp test gets when it sets none: the expected
+// origin ASNs, upstream neighbors or prefixes its sub type checks, separated by commas
+func getBgpAlertExpression(alertSubType string, prefixes []string, originAsns []int, upstreamNeighbors []int) string {
+	var values []string
+	switch alertSubType {
+	case "origin as":
+		for _, originAsn := range originAsns {
+			values = append(values, strconv.Itoa(originAsn))
+		}
+	case "origin neighbor":
+		for _, upstreamNeighbor := range upstreamNeighbors {
+			values = append(values, strconv.Itoa(upstreamNeighbor))
+		}
+	case "prefix mismatch":
+		values = prefixes
+	}
+	return strings.Join(values, ",")
+}
+
 func isValidEmail(email string) bool {
 	// Regular expression pattern for validating email addresses
 	pattern := `^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`
@@ -1075,3 +1038,340 @@ func isValidEmail(email string) bool {
 	// Use the MatchString method to check if the email matches the pattern
 	return regex.MatchString(email)
 }
+
+func getMaintenanceScheduleTypeId(scheduleType string) (int, string) {
+	scheduleTypes := map[int]string{
+		0: "one time",
+		1: "recurring",
+	}
+	for id, scheduleTypeString := range scheduleTypes {
+		if scheduleTypeString == scheduleType {
+			return id, scheduleTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getRecurrenceFrequencyId(recurrenceFrequency string) (int, string) {
+	recurrenceFrequencies := map[int]string{
+		1: "weekly",
+		2: "monthly",
+	}
+	for id, recurrenceFrequencyString := range recurrenceFrequencies {
+		if recurrenceFrequencyString == recurrenceFrequency {
+			return id, recurrenceFrequencyString
+		}
+	}
+	return -1, ""
+}
+
+func getRecurrenceFrequencyName(recurrenceFrequency int) string {
+	recurrenceFrequencies := map[int]string{
+		1: "weekly",
+		2: "monthly",
+	}
+	for id, recurrenceFrequencyString := range recurrenceFrequencies {
+		if id == recurrenceFrequency {
+			return recurrenceFrequencyString
+		}
+	}
+	return ""
+}
+
+func getDayOfWeekId(dayOfWeek string) (int, string) {
+	daysOfWeek := map[int]string{
+		0: "sunday",
+		1: "monday",
+		2: "tuesday",
+		3: "wednesday",
+		4: "thursday",
+		5: "friday",
+		6: "saturday",
+	}
+	for id, dayOfWeekString := range daysOfWeek {
+		if dayOfWeekString == dayOfWeek {
+			return id, dayOfWeekString
+		}
+	}
+	return -1, ""
+}
+
+func getDayOfWeekName(dayOfWeek int) string {
+	daysOfWeek := map[int]string{
+		0: "sunday",
+		1: "monday",
+		2: "tuesday",
+		3: "wednesday",
+		4: "thursday",
+		5: "friday",
+		6: "saturday",
+	}
+	for id, dayOfWeekString := range daysOfWeek {
+		if id == dayOfWeek {
+			return dayOfWeekString
+		}
+	}
+	return ""
+}
+
+func getWeekOfMonthId(weekOfMonth string) (int, string) {
+	weeksOfMonth := map[int]string{
+		1: "first",
+		2: "second",
+		3: "third",
+		4: "fourth",
+		5: "last",
+	}
+	for id, weekOfMonthString := range weeksOfMonth {
+		if weekOfMonthString == weekOfMonth {
+			return id, weekOfMonthString
+		}
+	}
+	return -1, ""
+}
+
+func getWeekOfMonthName(weekOfMonth int) string {
+	weeksOfMonth := map[int]string{
+		1: "first",
+		2: "second",
+		3: "third",
+		4: "fourth",
+		5: "last",
+	}
+	for id, weekOfMonthString := range weeksOfMonth {
+		if id == weekOfMonth {
+			return weekOfMonthString
+		}
+	}
+	return ""
+}
+
+func getRunScheduleTypeId(scheduleType string) (int, string) {
+	scheduleTypes := map[int]string{
+		0: "include",
+		1: "exclude",
+	}
+	for id, scheduleTypeString := range scheduleTypes {
+		if scheduleTypeString == scheduleType {
+			return id, scheduleTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getRunScheduleTypeName(scheduleType int) string {
+	scheduleTypes := map[int]string{
+		0: "include",
+		1: "exclude",
+	}
+	for id, scheduleTypeString := range scheduleTypes {
+		if id == scheduleType {
+			return scheduleTypeString
+		}
+	}
+	return ""
+}
+
+func getCaptureSourceId(captureSource string) (int, string) {
+	captureSources := map[int]string{
+		1: "request header",
+		2: "response header",
+		3: "cookie",
+		4: "response body",
+		5: "url",
+	}
+	for id, captureSourceString := range captureSources {
+		if captureSourceString == captureSource {
+			return id, captureSourceString
+		}
+	}
+	return -1, ""
+}
+
+func getCaptureSourceName(captureSource int) string {
+	captureSources := map[int]string{
+		1: "request header",
+		2: "response header",
+		3: "cookie",
+		4: "response body",
+		5: "url",
+	}
+	for id, captureSourceString := range captureSources {
+		if id == captureSource {
+			return captureSourceString
+		}
+	}
+	return ""
+}
+
+func getInsightDataTypeId(dataType string) (int, string) {
+	dataTypes := map[int]string{
+		1: "string",
+		2: "integer",
+		3: "decimal",
+	}
+	for id, dataTypeString := range dataTypes {
+		if dataTypeString == dataType {
+			return id, dataTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getInsightDataTypeName(dataType int) string {
+	dataTypes := map[int]string{
+		1: "string",
+		2: "integer",
+		3: "decimal",
+	}
+	for id, dataTypeString := range dataTypes {
+		if id == dataType {
+			return dataTypeString
+		}
+	}
+	return ""
+}
+
+func getNetworkTypeId(networkType string) (int, string) {
+	networkTypes := map[int]string{
+		0: "backbone",
+		1: "last mile",
+		2: "wireless",
+		3: "cloud",
+		4: "enterprise",
+	}
+	for id, networkTypeString := range networkTypes {
+		if networkTypeString == networkType {
+			return id, networkTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getNetworkTypeName(networkType int) string {
+	networkTypes := map[int]string{
+		0: "backbone",
+		1: "last mile",
+		2: "wireless",
+		3: "cloud",
+		4: "enterprise",
+	}
+	for id, networkTypeString := range networkTypes {
+		if id == networkType {
+			return networkTypeString
+		}
+	}
+	return ""
+}
+
+func getIpVersionName(ipVersion int) string {
+	ipVersions := map[int]string{
+		0: "ipv4",
+		1: "ipv6",
+		2: "dual stack",
+	}
+	for id, ipVersionString := range ipVersions {
+		if id == ipVersion {
+			return ipVersionString
+		}
+	}
+	return ""
+}
+
+func getLowerCaseStringSet(values []interface{}) map[string]bool {
+	set := map[string]bool{}
+	for _, value := range values {
+		set[strings.ToLower(value.(string))] = true
+	}
+	return set
+}
+
+// resolveNamesToIds maps each name to the id of the one entity carrying it, in the order of names. Unknown and
+// ambiguous names are errors, with a hint when the name only differs in case
+func resolveNamesToIds(entityKind string, names []interface{}, entityIds map[string][]int) ([]int, error) {
+	ids := make([]int, len(names))
+	for i, name := range names {
+		matches := entityIds[name.(string)]
+		if len(matches) > 1 {
+			matchIds := make([]string, len(matches))
+			for j, match := range matches {
+				matchIds[j] = strconv.Itoa(match)
+			}
+			return nil, errors.New(entityKind + " name " + name.(string) + " matches ids " + strings.Join(matchIds, ", ") + ". rename them or narrow the lookup")
+		}
+		if len(matches) == 0 {
+			for entityName := range entityIds {
+				if strings.EqualFold(entityName, name.(string)) {
+					return nil, errors.New("no " + entityKind + " named " + name.(string) + " was found. did you mean " + entityName + "?")
+				}
+			}
+			return nil, errors.New("no " + entityKind + " named " + name.(string) + " was found")
+		}
+		ids[i] = matches[0]
+	}
+	return ids, nil
+}
+
+func getTestTypeId(testType string) (int, string) {
+	testTypes := map[int]string{
+		int(Web):         "web",
+		int(Transaction): "transaction",
+		int(Dns):         "dns",
+		int(Ping):        "ping",
+		int(Api):         "api",
+		int(Traceroute):  "traceroute",
+		int(Ssl):         "ssl",
+		int(Bgp):         "bgp",
+		int(Playwright):  "playwright",
+		int(Puppeteer):   "puppeteer",
+		int(Smtp):        "smtp",
+		int(Ftp):         "ftp",
+		int(Tcp):         "tcp",
+		int(Udp):         "udp",
+		int(Ntp):         "ntp",
+		int(WebSocket):   "websocket",
+		int(Mqtt):        "mqtt",
+		int(Imap):        "imap",
+		int(Pop):         "pop",
+		int(Streaming):   "streaming",
+		int(Custom):      "custom",
+	}
+	for id, testTypeString := range testTypes {
+		if testTypeString == testType {
+			return id, testTypeString
+		}
+	}
+	return -1, ""
+}
+
+func getTestTypeName(testType int) string {
+	testTypes := map[int]string{
+		int(Web):         "web",
+		int(Transaction): "transaction",
+		int(Dns):         "dns",
+		int(Ping):        "ping",
+		int(Api):         "api",
+		int(Traceroute):  "traceroute",
+		int(Ssl):         "ssl",
+		int(Bgp):         "bgp",
+		int(Playwright):  "playwright",
+		int(Puppeteer):   "puppeteer",
+		int(Smtp):        "smtp",
+		int(Ftp):         "ftp",
+		int(Tcp):         "tcp",
+		int(Udp):         "udp",
+		int(Ntp):         "ntp",
+		int(WebSocket):   "websocket",
+		int(Mqtt):        "mqtt",
+		int(Imap):        "imap",
+		int(Pop):         "pop",
+		int(Streaming):   "streaming",
+		int(Custom):      "custom",
+	}
+	for id, testTypeString := range testTypes {
+		if id == testType {
+			return testTypeString
+		}
+	}
+	return ""
+}
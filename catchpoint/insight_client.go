@@ -0,0 +1,111 @@
+package catchpoint
+
+import (
+	"encoding/json"
+)
+
+type InsightCapture struct {
+	Source     GenericIdName `json:"source"`
+	SourceName string        `json:"sourceName,omitempty"`
+	Expression string        `json:"expression"`
+}
+
+type Tracepoint struct {
+	Id          int            `json:"id"`
+	DivisionId  int            `json:"divisionId"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Capture     InsightCapture `json:"capture"`
+	DataType    GenericIdName  `json:"dataType"`
+}
+
+type Indicator struct {
+	Id          int            `json:"id"`
+	DivisionId  int            `json:"divisionId"`
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	Capture     InsightCapture `json:"capture"`
+	DataType    GenericIdName  `json:"dataType"`
+}
+
+func getTracepoint(apiToken string, tracepointId string) (*Tracepoint, string, error) {
+
+	type Data struct {
+		Tracepoints []Tracepoint `json:"tracepoints"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/tracepoints/" + tracepointId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &Tracepoint{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Tracepoint not found
+	if !response.Completed || len(response.ResponseData.Tracepoints) == 0 {
+		return nil, responseStatus, nil
+	}
+	tracepoint := response.ResponseData.Tracepoints[0]
+
+	return &tracepoint, responseStatus, nil
+}
+
+func createTracepoint(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/tracepoints", jsonPayload)
+}
+
+func updateTracepoint(apiToken string, tracepointId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/tracepoints/"+tracepointId, jsonPayload)
+}
+
+func deleteTracepoint(apiToken string, tracepointId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/tracepoints/"+tracepointId)
+}
+
+func getIndicator(apiToken string, indicatorId string) (*Indicator, string, error) {
+
+	type Data struct {
+		Indicators []Indicator `json:"indicators"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/indicators/" + indicatorId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &Indicator{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Indicator not found
+	if !response.Completed || len(response.ResponseData.Indicators) == 0 {
+		return nil, responseStatus, nil
+	}
+	indicator := response.ResponseData.Indicators[0]
+
+	return &indicator, responseStatus, nil
+}
+
+func createIndicator(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/indicators", jsonPayload)
+}
+
+func updateIndicator(apiToken string, indicatorId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/indicators/"+indicatorId, jsonPayload)
+}
+
+func deleteIndicator(apiToken string, indicatorId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/indicators/"+indicatorId)
+}
@@ -0,0 +1,165 @@
+package catchpoint
+
+import (
+	"encoding/json"
+)
+
+// Secret values are write-only. The API accepts them on create and update but never returns them
+type LibraryPassword struct {
+	Id          int    `json:"id"`
+	DivisionId  int    `json:"divisionId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Username    string `json:"username"`
+	Password    string `json:"password,omitempty"`
+}
+
+type LibraryToken struct {
+	Id          int    `json:"id"`
+	DivisionId  int    `json:"divisionId"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Value       string `json:"value,omitempty"`
+}
+
+type LibraryCertificate struct {
+	Id             int    `json:"id"`
+	DivisionId     int    `json:"divisionId"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Certificate    string `json:"certificate,omitempty"`
+	PrivateKey     string `json:"privateKey,omitempty"`
+	PassPhrase     string `json:"passPhrase,omitempty"`
+	Subject        string `json:"subject,omitempty"`
+	ExpirationDate string `json:"expirationDate,omitempty"`
+}
+
+func getLibraryPassword(apiToken string, passwordId string) (*LibraryPassword, string, error) {
+
+	type Data struct {
+		Passwords []LibraryPassword `json:"passwords"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/library/passwords/" + passwordId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &LibraryPassword{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Password not found
+	if !response.Completed || len(response.ResponseData.Passwords) == 0 {
+		return nil, responseStatus, nil
+	}
+	password := response.ResponseData.Passwords[0]
+
+	return &password, responseStatus, nil
+}
+
+func createLibraryPassword(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/library/passwords", jsonPayload)
+}
+
+func updateLibraryPassword(apiToken string, passwordId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/library/passwords/"+passwordId, jsonPayload)
+}
+
+func deleteLibraryPassword(apiToken string, passwordId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/library/passwords/"+passwordId)
+}
+
+func getLibraryToken(apiToken string, tokenId string) (*LibraryToken, string, error) {
+
+	type Data struct {
+		Tokens []LibraryToken `json:"tokens"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/library/tokens/" + tokenId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &LibraryToken{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Token not found
+	if !response.Completed || len(response.ResponseData.Tokens) == 0 {
+		return nil, responseStatus, nil
+	}
+	token := response.ResponseData.Tokens[0]
+
+	return &token, responseStatus, nil
+}
+
+func createLibraryToken(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/library/tokens", jsonPayload)
+}
+
+func updateLibraryToken(apiToken string, tokenId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/library/tokens/"+tokenId, jsonPayload)
+}
+
+func deleteLibraryToken(apiToken string, tokenId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/library/tokens/"+tokenId)
+}
+
+func getLibraryCertificate(apiToken string, certificateId string) (*LibraryCertificate, string, error) {
+
+	type Data struct {
+		Certificates []LibraryCertificate `json:"certificates"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/library/certificates/" + certificateId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &LibraryCertificate{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Certificate not found
+	if !response.Completed || len(response.ResponseData.Certificates) == 0 {
+		return nil, responseStatus, nil
+	}
+	certificate := response.ResponseData.Certificates[0]
+
+	return &certificate, responseStatus, nil
+}
+
+func createLibraryCertificate(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/library/certificates", jsonPayload)
+}
+
+func updateLibraryCertificate(apiToken string, certificateId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/library/certificates/"+certificateId, jsonPayload)
+}
+
+func deleteLibraryCertificate(apiToken string, certificateId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/library/certificates/"+certificateId)
+}
+
+// marshalSecretPayload encodes a create or update payload that carries secrets, such as library
+// credentials or webhook headers. The result is never logged, even when log_json is enabled
+func marshalSecretPayload(payload interface{}) string {
+	payloadJson, _ := json.Marshal(payload)
+	return string(payloadJson)
+}
@@ -0,0 +1,67 @@
+package catchpoint
+
+import (
+	"encoding/json"
+)
+
+type MaintenanceScheduleRecurrence struct {
+	Frequency   GenericIdName           `json:"frequency"`
+	Interval    int                     `json:"interval,omitempty"`
+	DaysOfWeek  []GenericIdName         `json:"daysOfWeek,omitempty"`
+	DayOfMonth  int                     `json:"dayOfMonth,omitempty"`
+	WeekOfMonth *GenericIdNameOmitEmpty `json:"weekOfMonth,omitempty"`
+	EndTime     string                  `json:"endTime,omitempty"`
+}
+
+type MaintenanceSchedule struct {
+	Id           int                            `json:"id"`
+	DivisionId   int                            `json:"divisionId"`
+	Name         string                         `json:"name"`
+	Description  string                         `json:"description"`
+	ScheduleType GenericIdName                  `json:"scheduleType"`
+	TimeZone     string                         `json:"timeZone"`
+	StartTime    string                         `json:"startTime"`
+	Duration     int                            `json:"durationMinutes"`
+	Recurrence   *MaintenanceScheduleRecurrence `json:"recurrence,omitempty"`
+}
+
+func getMaintenanceSchedule(apiToken string, scheduleId string) (*MaintenanceSchedule, string, error) {
+
+	type Data struct {
+		MaintenanceSchedules []MaintenanceSchedule `json:"maintenanceSchedules"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/maintenanceschedules/" + scheduleId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &MaintenanceSchedule{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Maintenance schedule not found
+	if !response.Completed || len(response.ResponseData.MaintenanceSchedules) == 0 {
+		return nil, responseStatus, nil
+	}
+	schedule := response.ResponseData.MaintenanceSchedules[0]
+
+	return &schedule, responseStatus, nil
+}
+
+func createMaintenanceSchedule(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/maintenanceschedules", jsonPayload)
+}
+
+func updateMaintenanceSchedule(apiToken string, scheduleId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/maintenanceschedules/"+scheduleId, jsonPayload)
+}
+
+func deleteMaintenanceSchedule(apiToken string, scheduleId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/maintenanceschedules/"+scheduleId)
+}
@@ -0,0 +1,59 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const nodesPageSize = 100
+
+// NodeDetail is a node as listed by the nodes endpoint, as opposed to the Node reference embedded in tests
+type NodeDetail struct {
+	Id          int           `json:"id"`
+	Name        string        `json:"name"`
+	NetworkType GenericIdName `json:"networkType"`
+	Country     GenericIdName `json:"country"`
+	City        GenericIdName `json:"city"`
+	Region      GenericIdName `json:"region"`
+	Isp         GenericIdName `json:"isp"`
+	Asn         int           `json:"asn"`
+	IpVersion   GenericIdName `json:"ipVersion"`
+	Status      GenericIdName `json:"status"`
+}
+
+// getNodes pages through every node visible to the API token. Filtering is left to the caller
+func getNodes(apiToken string) ([]NodeDetail, string, error) {
+
+	type Data struct {
+		Nodes []NodeDetail `json:"nodes"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var nodes []NodeDetail
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointApiURI + "/nodes?pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(nodesPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		nodes = append(nodes, response.ResponseData.Nodes...)
+		if len(response.ResponseData.Nodes) < nodesPageSize {
+			break
+		}
+	}
+
+	return nodes, responseStatus, nil
+}
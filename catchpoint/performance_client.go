@@ -0,0 +1,129 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const performanceTimeFormat = "2006-01-02T15:04:05"
+
+const testRunsPageSize = 100
+
+// Synthetic metric names holding the test time, in order of preference. Web style tests report a response
+// time while the other test types report the overall test time
+var testTimeMetricNames = []string{"test time (ms)", "response (ms)", "total (ms)"}
+
+// TestRun is a single raw run of a test on a node
+type TestRun struct {
+	NodeId      int
+	NodeName    string
+	TestTime    float64
+	HasTestTime bool
+	Failed      bool
+}
+
+// PerformanceField describes a column of the metrics reported for each run
+type PerformanceField struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+}
+
+// getTestRuns pages through the raw runs of a test between startTime and endTime from the performance explorer
+func getTestRuns(apiToken string, testId int, startTime time.Time, endTime time.Time, nodeIds []int) ([]TestRun, string, error) {
+
+	type Fields struct {
+		SyntheticMetrics []PerformanceField `json:"synthetic_metrics"`
+	}
+	type ErrorDetail struct {
+		Code json.Number `json:"code"`
+		Name string      `json:"name"`
+	}
+	type Item struct {
+		Breakdown1       GenericIdName `json:"breakdown_1"`
+		SyntheticMetrics []*float64    `json:"synthetic_metrics"`
+		Error            *ErrorDetail  `json:"error"`
+	}
+	type Detail struct {
+		Fields Fields `json:"fields"`
+		Items  []Item `json:"items"`
+	}
+	type Data struct {
+		Detail Detail `json:"detail"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	query := url.Values{}
+	query.Set("testIds", strconv.Itoa(testId))
+	query.Set("startTime", startTime.UTC().Format(performanceTimeFormat))
+	query.Set("endTime", endTime.UTC().Format(performanceTimeFormat))
+	if len(nodeIds) > 0 {
+		nodeIdStrings := make([]string, len(nodeIds))
+		for i, nodeId := range nodeIds {
+			nodeIdStrings[i] = strconv.Itoa(nodeId)
+		}
+		query.Set("nodeIds", strings.Join(nodeIdStrings, ","))
+	}
+
+	query.Set("pageSize", strconv.Itoa(testRunsPageSize))
+
+	var runs []TestRun
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		query.Set("pageNumber", strconv.Itoa(pageNumber))
+		getURL := catchpointTestURI + "/explorer/raw?" + query.Encode()
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		if !response.Completed {
+			return nil, responseStatus, errors.New(string(body))
+		}
+
+		testTimeIndex := getTestTimeMetricIndex(response.ResponseData.Detail.Fields.SyntheticMetrics)
+		for _, item := range response.ResponseData.Detail.Items {
+			run := TestRun{
+				NodeId:   item.Breakdown1.Id,
+				NodeName: item.Breakdown1.Name,
+				Failed:   item.Error != nil,
+			}
+			if testTimeIndex >= 0 && testTimeIndex < len(item.SyntheticMetrics) && item.SyntheticMetrics[testTimeIndex] != nil {
+				run.TestTime = *item.SyntheticMetrics[testTimeIndex]
+				run.HasTestTime = true
+			}
+			runs = append(runs, run)
+		}
+		if len(response.ResponseData.Detail.Items) < testRunsPageSize {
+			break
+		}
+	}
+
+	return runs, responseStatus, nil
+}
+
+// getTestTimeMetricIndex returns the index of the test time in the synthetic metrics of a run, or -1
+func getTestTimeMetricIndex(fields []PerformanceField) int {
+	for _, metricName := range testTimeMetricNames {
+		for _, field := range fields {
+			if strings.ToLower(strings.TrimSpace(field.Name)) == metricName {
+				return field.Index
+			}
+		}
+	}
+	return -1
+}
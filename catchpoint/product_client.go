@@ -0,0 +1,88 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+const productsPageSize = 100
+
+// Product carries the settings the tests and folders of the product inherit
+type Product struct {
+	Id               int               `json:"id"`
+	Name             string            `json:"name"`
+	DivisionId       int               `json:"divisionId"`
+	Status           GenericIdName     `json:"status"`
+	RequestSettings  RequestSetting    `json:"requestSettings"`
+	AlertGroup       AlertGroupStruct  `json:"alertGroup"`
+	InsightData      InsightDataStruct `json:"insightData"`
+	ScheduleSettings ScheduleSetting   `json:"scheduleSettings"`
+	AdvancedSettings AdvancedSetting   `json:"advancedSettings"`
+}
+
+// getProducts pages through the products of a division
+func getProducts(apiToken string, divisionId int) ([]Product, string, error) {
+
+	type Data struct {
+		Products []Product `json:"products"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var products []Product
+	responseStatus := ""
+	for pageNumber := 1; ; pageNumber++ {
+		var response Response
+		getURL := catchpointApiURI + "/products?divisionId=" + strconv.Itoa(divisionId) + "&pageNumber=" + strconv.Itoa(pageNumber) + "&pageSize=" + strconv.Itoa(productsPageSize)
+		body, status, err := sendRequest(apiToken, "GET", getURL, nil)
+		responseStatus = status
+		if err != nil {
+			return nil, responseStatus, err
+		}
+		if responseStatus != "200 ok" {
+			return nil, responseStatus, nil
+		}
+		json.Unmarshal(body, &response)
+		products = append(products, response.ResponseData.Products...)
+		if len(response.ResponseData.Products) < productsPageSize {
+			break
+		}
+	}
+
+	return products, responseStatus, nil
+}
+
+// getProduct reads a product with its effective settings, including the ones inherited from the division
+func getProduct(apiToken string, productId int) (*Product, string, error) {
+
+	type Data struct {
+		Products []Product `json:"products"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/products/" + strconv.Itoa(productId) + "?showInheritedProperties=true"
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return nil, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Product not found
+	if !response.Completed || len(response.ResponseData.Products) == 0 {
+		return nil, responseStatus, nil
+	}
+	product := response.ResponseData.Products[0]
+
+	return &product, responseStatus, nil
+}
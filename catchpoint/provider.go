@@ -1,13 +1,16 @@
 package catchpoint
 
 import (
+	"os"
+	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"api_token": {
 				Type:        schema.TypeString,
@@ -27,6 +30,24 @@ func Provider() *schema.Provider {
 				Description: "Set the environment to stage, qa or prod. This is for internal use",
 				DefaultFunc: schema.EnvDefaultFunc("CATCHPOINT_ENVIRONMENT", nil),
 			},
+			"live_enums": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Load the enumeration catalogues (monitors, alert sub types, DNS query types, frequencies, reminders, test flags) from the Catchpoint reference endpoints instead of only using the built-in ones. Accepts string and converts to bool using ParseBool function",
+				DefaultFunc: schema.EnvDefaultFunc("CATCHPOINT_LIVE_ENUMS", nil),
+			},
+			"enum_cache_dir": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Directory where the live enumeration catalogues are cached. Defaults to terraform-provider-catchpoint in the user cache directory",
+				DefaultFunc: schema.EnvDefaultFunc("CATCHPOINT_ENUM_CACHE_DIR", nil),
+			},
+			"enum_cache_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     24,
+				Description: "Hours the cached enumeration catalogues are used before they are loaded from the API again. Defaults to 24",
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"web_test":         resourceWebTestType(),
@@ -37,11 +58,51 @@ func Provider() *schema.Provider {
 			"bgp_test":         resourceBgpTestType(),
 			"dns_test":         resourceDnsTestType(),
 			"ssl_test":         resourceSslTestType(),
+			"smtp_test":        resourceSmtpTestType(),
+			"ftp_test":         resourceFtpTestType(),
+			"tcp_test":         resourceTcpTestType(),
+			"udp_test":         resourceUdpTestType(),
+			"ntp_test":         resourceNtpTestType(),
+			"websocket_test":   resourceWebSocketTestType(),
+			"mqtt_test":        resourceMqttTestType(),
+			"imap_test":        resourceImapTestType(),
+			"pop_test":         resourcePopTestType(),
+			"streaming_test":   resourceStreamingTestType(),
+			"custom_test":      resourceCustomTestType(),
 			"playwright_test":  resourcePlaywrightTestType(),
 			"puppeteer_test":   resourcePuppeteerTestType(),
+
+			"catchpoint_maintenance_schedule": resourceMaintenanceSchedule(),
+			"catchpoint_run_schedule":         resourceRunSchedule(),
+			"catchpoint_tracepoint":           resourceTracepoint(),
+			"catchpoint_indicator":            resourceIndicator(),
+			"catchpoint_library_password":     resourceLibraryPassword(),
+			"catchpoint_library_token":        resourceLibraryToken(),
+			"catchpoint_library_certificate":  resourceLibraryCertificate(),
+			"catchpoint_test_data_webhook":    resourceTestDataWebhook(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"catchpoint_nodes":            dataSourceNodes(),
+			"catchpoint_test":             dataSourceTestType(),
+			"catchpoint_tests":            dataSourceTestsType(),
+			"catchpoint_chrome_versions":  dataSourceChromeVersions(),
+			"catchpoint_enums":            dataSourceEnums(),
+			"catchpoint_test_performance": dataSourceTestPerformance(),
+			"catchpoint_alerts":           dataSourceAlerts(),
+			"catchpoint_contact_groups":   dataSourceContactGroups(),
+			"catchpoint_alert_webhooks":   dataSourceAlertWebhooks(),
+			"catchpoint_product":          dataSourceProduct(),
+			"catchpoint_folder":           dataSourceFolder(),
+			"catchpoint_test_payload":     dataSourceTestPayload(),
 		},
 		ConfigureFunc: providerConfigure,
 	}
+
+	settingsSchema := inheritedSettingsSchema()
+	for name := range testPayloadRenderers() {
+		withEffectiveSettings(p.ResourcesMap[name], settingsSchema)
+	}
+	return p
 }
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
@@ -53,5 +114,22 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	catchpoint_environment := d.Get("catchpoint_environment").(string)
 	setTestUriByEnv(catchpoint_environment)
-	return newConfig(api_token, is_log_json, catchpoint_environment), nil
+
+	config := newConfig(api_token, is_log_json, catchpoint_environment)
+
+	live_enums := d.Get("live_enums").(string)
+	is_live_enums, err := strconv.ParseBool(live_enums)
+	if err == nil && is_live_enums {
+		enum_cache_dir := d.Get("enum_cache_dir").(string)
+		if enum_cache_dir == "" {
+			user_cache_dir, err := os.UserCacheDir()
+			if err != nil {
+				user_cache_dir = os.TempDir()
+			}
+			enum_cache_dir = filepath.Join(user_cache_dir, "terraform-provider-catchpoint")
+		}
+		enum_cache_ttl := time.Duration(d.Get("enum_cache_ttl").(int)) * time.Hour
+		loadLiveEnumCatalogues(config, enum_cache_dir, enum_cache_ttl)
+	}
+	return config, nil
 }
@@ -19,14 +19,14 @@ func resourceApiTestType() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateEnumCatalogueDiff,
 
 		Schema: map[string]*schema.Schema{
 			"monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The monitor to use for the Api Test. Supported: 'api'",
-				Default:      "api",
-				ValidateFunc: validation.StringInSlice([]string{"api"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The monitor to use for the Api Test. Supported: 'api'",
+				Default:     "api",
 			},
 			"division_id": {
 				Type:        schema.TypeInt,
@@ -75,8 +75,8 @@ func resourceApiTestType() *schema.Resource {
 			"enable_test_data_webhook": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
-				Description: "Optional. Switch for enabling test data webhook feature",
+				Default:     false,
+				Description: "Optional. Switch for streaming the test data to the division's test data webhook (see catchpoint_test_data_webhook). Defaults to false",
 			},
 			"alerts_paused": {
 				Type:        schema.TypeBool,
@@ -509,10 +509,9 @@ func resourceApiTestType() *schema.Resource {
 							Description: "Optional. The maintenance schedule id to utilize for the test",
 						},
 						"frequency": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
-							ValidateFunc: validation.StringInSlice([]string{"1 minute", "5 minutes", "10 minutes", "15 minutes", "20 minutes", "30 minutes", "60 minutes", "2 hours", "3 hours", "4 hours", "6 hours", "8 hours", "12 hours", "24 hours", "4 minutes", "2 minutes"}, false),
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
 						},
 						"node_distribution": {
 							Type:         schema.TypeString,
@@ -630,16 +629,14 @@ func resourceApiTestType() *schema.Resource {
 										Description: "Optional. Sets trigger expression for content match alert type ",
 									},
 									"warning_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"critical_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"threshold_interval": {
 										Type:         schema.TypeString,
@@ -666,10 +663,9 @@ func resourceApiTestType() *schema.Resource {
 										ValidateFunc: validation.StringInSlice([]string{"test failure", "timing", "availability", "host failure", "requests", "content match", "byte length"}, false),
 									},
 									"alert_sub_type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets the sub alert type: 'dns', 'connect', 'send', 'wait', 'load', 'ttfb', 'content load', 'response', 'test time', 'dom load', 'test time with suspect', 'server response', 'document complete', 'redirect', 'test', 'content', '% downtime'",
-										ValidateFunc: validation.StringInSlice([]string{"dns", "connect", "send", "wait", "load", "ttfb", "content load", "response", "test time", "dom load", "test time with suspect", "server response", "document complete", "redirect", "test", "content", "% downtime", "# requests", "# hosts", "# connections", "# redirects", "# other", "# images", "# scripts", "# html", "# css", "# xml", "# flash", "# media", "regular expression", "response code", "response headers", "byte length", "page", "file size"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets the sub alert type: 'dns', 'connect', 'send', 'wait', 'load', 'ttfb', 'content load', 'response', 'test time', 'dom load', 'test time with suspect', 'server response', 'document complete', 'redirect', 'test', 'content', '% downtime'",
 									},
 									"enforce_test_failure": {
 										Type:        schema.TypeBool,
@@ -942,8 +938,40 @@ func resourceApiTestType() *schema.Resource {
 
 func resourceApiTestCreate(d *schema.ResourceData, m interface{}) error {
 	api_token := m.(*Config).ApiToken
+	testConfig, err := buildApiTestConfig(d, m)
+	if err != nil {
+		return err
+	}
+	test_name := testConfig.TestName
+
+	jsonStr := createJson(testConfig)
+
+	if m.(*Config).LogJson {
+		log.Printf("[TEST JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating test: " + test_name)
+	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating test: " + test_name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(testId)
+	return resourceApiTestRead(d, m)
+}
+
+// buildApiTestConfig builds the configuration of a new api test from the resource data
+func buildApiTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
 	monitor := d.Get("monitor").(string)
-	monitor_id := getMonitorId(monitor)
+	monitor_id := getMonitorId(m.(*Config), monitor)
 	division_id := d.Get("division_id").(int)
 	product_id := d.Get("product_id").(int)
 	folder_id := d.Get("folder_id").(int)
@@ -1006,7 +1034,7 @@ func resourceApiTestCreate(d *schema.ResourceData, m interface{}) error {
 
 		err := setRequestSettings(int(test_type), request_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1023,9 +1051,9 @@ func resourceApiTestCreate(d *schema.ResourceData, m interface{}) error {
 		schedule_setting_list := schedule_settings.(*schema.Set).List()
 		schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-		err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+		err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1034,9 +1062,9 @@ func resourceApiTestCreate(d *schema.ResourceData, m interface{}) error {
 		alert_setting_list := alert_settings.(*schema.Set).List()
 		alert_setting := alert_setting_list[0].(map[string]interface{})
 
-		err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+		err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1045,31 +1073,10 @@ func resourceApiTestCreate(d *schema.ResourceData, m interface{}) error {
 		advanced_setting_list := advanced_settings.(*schema.Set).List()
 		advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-		setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
+		setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 	}
 
-	jsonStr := createJson(testConfig)
-
-	if m.(*Config).LogJson {
-		log.Printf("[TEST JSON] \n" + jsonStr)
-	}
-
-	log.Printf("[DEBUG] Creating test: " + test_name)
-	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if respStatus != "200 ok" {
-		log.Printf("[ERROR] Error while creating test: " + test_name)
-		log.Printf("[ERROR] Error description: " + respBody)
-		return errors.New(respStatus)
-	}
-
-	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-	log.Print(respBody)
-
-	d.SetId(testId)
-	return resourceApiTestRead(d, m)
+	return testConfig, nil
 }
 
 func resourceApiTestRead(d *schema.ResourceData, m interface{}) error {
@@ -1093,7 +1100,7 @@ func resourceApiTestRead(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
 
-	testNew := flattenTest(test)
+	testNew := flattenTest(m.(*Config), test)
 
 	d.Set("monitor", testNew["monitor"])
 	d.Set("division_id", testNew["division_id"])
@@ -1117,12 +1124,48 @@ func resourceApiTestRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("alert_settings", testNew["alert_settings"])
 	d.Set("advanced_settings", testNew["advanced_settings"])
 
+	if err := setEffectiveSettings(d, m.(*Config), testId); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 	testId := d.Id()
 	api_token := m.(*Config).ApiToken
+	jsonPatchDocs, err := buildApiTestJsonPatchDocs(d, m)
+	if err != nil {
+		return err
+	}
+
+	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
+
+	if jsonPatchDoc != "[]" {
+		log.Printf("[DEBUG] Updating test: %v", testId)
+		if m.(*Config).LogJson {
+			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
+		}
+		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !completed {
+			log.Printf("[ERROR] Error while Updating test: %v", testId)
+			log.Printf("[ERROR] Error description: " + respBody)
+			return errors.New(respBody)
+		}
+		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+		log.Print(respBody)
+
+		return resourceApiTestRead(d, m)
+	} else {
+		return errors.New("no changes. Your infrastructure matches the configuration")
+	}
+}
+
+// buildApiTestJsonPatchDocs builds the JSON Patch operations for the attributes of a api test that changed
+func buildApiTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
 	test_type := TestType(Api)
 	var testConfig = TestConfig{}
 	var jsonPatchDocs = []string{}
@@ -1187,7 +1230,7 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 
 	if d.HasChange("test_script") {
 		test_script_type_id := getApiScriptTypeId(d.Get("test_script_type").(string))
-		monitor_id := getMonitorId(d.Get("monitor").(string))
+		monitor_id := getMonitorId(m.(*Config), d.Get("monitor").(string))
 		setRequestData(int(test_type), d.Get("test_script").(string), monitor_id, test_script_type_id, &testConfig)
 
 		testConfigUpdate := TestConfigUpdate{
@@ -1235,7 +1278,7 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 			advanced_setting_list := advanced_settings.(*schema.Set).List()
 			advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-			setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
+			setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedAdvancedSettingsSection: setTestAdvancedSettings(&testConfig),
@@ -1253,7 +1296,7 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 
 			err := setRequestSettings(int(test_type), request_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedRequestSettingsSection: setTestRequestSettings(&testConfig),
@@ -1285,9 +1328,9 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 			schedule_setting_list := schedule_settings.(*schema.Set).List()
 			schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-			err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+			err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -1304,9 +1347,9 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 			alert_setting_list := alert_settings.(*schema.Set).List()
 			alert_setting := alert_setting_list[0].(map[string]interface{})
 
-			err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+			err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -1317,29 +1360,7 @@ func resourceApiTestUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
-
-	if jsonPatchDoc != "[]" {
-		log.Printf("[DEBUG] Updating test: %v", testId)
-		if m.(*Config).LogJson {
-			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
-		}
-		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
-		if err != nil {
-			log.Fatal(err)
-		}
-		if !completed {
-			log.Printf("[ERROR] Error while Updating test: %v", testId)
-			log.Printf("[ERROR] Error description: " + respBody)
-			return errors.New(respBody)
-		}
-		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-		log.Print(respBody)
-
-		return resourceApiTestRead(d, m)
-	} else {
-		return errors.New("no changes. Your infrastructure matches the configuration")
-	}
+	return jsonPatchDocs, nil
 }
 
 func resourceApiTestDelete(d *schema.ResourceData, m interface{}) error {
@@ -19,14 +19,14 @@ func resourceBgpTestType() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateEnumCatalogueDiff,
 
 		Schema: map[string]*schema.Schema{
 			"monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The monitor to use for the BGP Test. Supported: 'bgp','bgp basic'",
-				Default:      "bgp",
-				ValidateFunc: validation.StringInSlice([]string{"bgp", "bgp basic"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The monitor to use for the BGP Test. Supported: 'bgp','bgp basic'",
+				Default:     "bgp",
 			},
 			"division_id": {
 				Type:        schema.TypeInt,
@@ -50,9 +50,41 @@ func resourceBgpTestType() *schema.Resource {
 				Description: "The name of the Test",
 			},
 			"prefix": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "IPV4 address with a netmask range from 8 to 24 or IPV6 address with a netmask range from 28 to 128",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Exactly one of prefix and prefixes must be set. IPV4 address with a netmask range from 8 to 24 or IPV6 address with a netmask range from 28 to 128",
+				Deprecated:   "Use prefixes, which monitors one or more prefixes in the same test",
+				ValidateFunc: validateBgpPrefix,
+				ExactlyOneOf: []string{"prefix", "prefixes"},
+			},
+			"prefixes": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				Description:  "Exactly one of prefix and prefixes must be set. IPV4 addresses with a netmask range from 8 to 24 or IPV6 addresses with a netmask range from 28 to 128",
+				ExactlyOneOf: []string{"prefix", "prefixes"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateBgpPrefix,
+				},
+			},
+			"expected_origin_asns": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. ASNs expected to originate the prefixes. ASN alert rules of the 'origin as' sub type without an expression alert on any other origin",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 4294967295),
+				},
+			},
+			"expected_upstream_neighbors": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. ASNs expected to neighbor the origin upstream. ASN alert rules of the 'origin neighbor' sub type without an expression alert on any other neighbor",
+				Elem: &schema.Schema{
+					Type:         schema.TypeInt,
+					ValidateFunc: validation.IntBetween(1, 4294967295),
+				},
 			},
 			"test_description": {
 				Type:        schema.TypeString,
@@ -63,8 +95,8 @@ func resourceBgpTestType() *schema.Resource {
 			"enable_test_data_webhook": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
-				Description: "Optional. Switch for enabling test data webhook feature",
+				Default:     false,
+				Description: "Optional. Switch for streaming the test data to the division's test data webhook (see catchpoint_test_data_webhook). Defaults to false",
 			},
 			"alerts_paused": {
 				Type:        schema.TypeBool,
@@ -194,19 +226,17 @@ func resourceBgpTestType() *schema.Resource {
 									"expression": {
 										Type:        schema.TypeString,
 										Optional:    true,
-										Description: "Optional. Sets trigger expression for ASN alert type ",
+										Description: "Optional. Sets trigger expression for ASN alert type. Rules of the 'origin as', 'origin neighbor' and 'prefix mismatch' sub types default to expected_origin_asns, expected_upstream_neighbors and prefixes",
 									},
 									"warning_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"critical_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"threshold_interval": {
 										Type:         schema.TypeString,
@@ -233,10 +263,9 @@ func resourceBgpTestType() *schema.Resource {
 										ValidateFunc: validation.StringInSlice([]string{"test failure", "asn"}, false),
 									},
 									"alert_sub_type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets the sub alert type: 'origin as', 'path as', 'origin neighbor', 'prefix mismatch'",
-										ValidateFunc: validation.StringInSlice([]string{"origin as", "path as", "origin neighbor", "prefix mismatch"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets the sub alert type: 'origin as', 'path as', 'origin neighbor', 'prefix mismatch'",
 									},
 									"enforce_test_failure": {
 										Type:        schema.TypeBool,
@@ -350,13 +379,47 @@ func resourceBgpTestType() *schema.Resource {
 
 func resourceBgpTestCreate(d *schema.ResourceData, m interface{}) error {
 	api_token := m.(*Config).ApiToken
+	testConfig, err := buildBgpTestConfig(d, m)
+	if err != nil {
+		return err
+	}
+	test_name := testConfig.TestName
+
+	jsonStr := createJson(testConfig)
+
+	if m.(*Config).LogJson {
+		log.Printf("[TEST JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating test: " + test_name)
+	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating test: " + test_name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(testId)
+	return resourceBgpTestRead(d, m)
+}
+
+// buildBgpTestConfig builds the configuration of a new bgp test from the resource data
+func buildBgpTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
 	monitor := d.Get("monitor").(string)
-	monitor_id := getMonitorId(monitor)
+	monitor_id := getMonitorId(m.(*Config), monitor)
 	division_id := d.Get("division_id").(int)
 	product_id := d.Get("product_id").(int)
 	folder_id := d.Get("folder_id").(int)
 	test_name := d.Get("test_name").(string)
-	prefix := d.Get("prefix").(string)
+	prefixes := getBgpTestPrefixes(d)
+	origin_asns := d.Get("expected_origin_asns").([]interface{})
+	upstream_neighbors := d.Get("expected_upstream_neighbors").([]interface{})
 	test_description := d.Get("test_description").(string)
 	enable_test_data_webhook := d.Get("enable_test_data_webhook").(bool)
 	alerts_paused := d.Get("alerts_paused").(bool)
@@ -373,7 +436,7 @@ func resourceBgpTestCreate(d *schema.ResourceData, m interface{}) error {
 
 	testConfig = TestConfig{
 		TestType:              int(test_type),
-		TestUrl:               prefix,
+		TestUrl:               prefixes[0],
 		Monitor:               monitor_id,
 		DivisionId:            division_id,
 		ProductId:             product_id,
@@ -387,6 +450,8 @@ func resourceBgpTestCreate(d *schema.ResourceData, m interface{}) error {
 		TestStatus:            status_id,
 	}
 
+	setBgpSettings(int(test_type), prefixes, origin_asns, upstream_neighbors, &testConfig)
+
 	label, labelOk := d.GetOk("label")
 	if labelOk {
 		label_lists := label.(*schema.Set).List()
@@ -399,34 +464,27 @@ func resourceBgpTestCreate(d *schema.ResourceData, m interface{}) error {
 		alert_setting_list := alert_settings.(*schema.Set).List()
 		alert_setting := alert_setting_list[0].(map[string]interface{})
 
-		err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+		err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
+		setBgpAlertExpressions(&testConfig)
 	}
 
-	jsonStr := createJson(testConfig)
+	return testConfig, nil
+}
 
-	if m.(*Config).LogJson {
-		log.Printf("[TEST JSON] \n" + jsonStr)
+// getBgpTestPrefixes returns the prefixes of a bgp test, set either as the deprecated prefix or as prefixes
+func getBgpTestPrefixes(d *schema.ResourceData) []string {
+	if prefix := d.Get("prefix").(string); prefix != "" {
+		return []string{prefix}
 	}
-
-	log.Printf("[DEBUG] Creating test: " + test_name)
-	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
-	if err != nil {
-		log.Fatal(err)
+	prefixes := d.Get("prefixes").([]interface{})
+	prefixes_list := make([]string, len(prefixes))
+	for i, prefix := range prefixes {
+		prefixes_list[i] = prefix.(string)
 	}
-	if respStatus != "200 ok" {
-		log.Printf("[ERROR] Error while creating test: " + test_name)
-		log.Printf("[ERROR] Error description: " + respBody)
-		return errors.New(respStatus)
-	}
-
-	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-	log.Print(respBody)
-
-	d.SetId(testId)
-	return resourceBgpTestRead(d, m)
+	return prefixes_list
 }
 
 func resourceBgpTestRead(d *schema.ResourceData, m interface{}) error {
@@ -450,7 +508,7 @@ func resourceBgpTestRead(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
 
-	testNew := flattenTest(test)
+	testNew := flattenTest(m.(*Config), test)
 
 	d.Set("monitor", testNew["monitor"])
 	d.Set("division_id", testNew["division_id"])
@@ -463,16 +521,95 @@ func resourceBgpTestRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("start_time", testNew["start_time"])
 	d.Set("end_time", testNew["end_time"])
 	d.Set("status", testNew["status"])
-	d.Set("prefix", testNew["test_url"])
+	// Tests configured with the deprecated prefix keep reading it back, everything else reads prefixes
+	if d.Get("prefix").(string) != "" {
+		d.Set("prefix", testNew["test_url"])
+	} else {
+		d.Set("prefixes", testNew["prefixes"])
+	}
+	d.Set("expected_origin_asns", testNew["expected_origin_asns"])
+	d.Set("expected_upstream_neighbors", testNew["expected_upstream_neighbors"])
 	d.Set("label", testNew["label"])
-	d.Set("alert_settings", testNew["alert_settings"])
+	alert_settings := testNew["alert_settings"].([]interface{})
+	omitBgpAlertExpressions(alert_settings, d.Get("alert_settings").(*schema.Set).List(), test.BgpSettings)
+	d.Set("alert_settings", alert_settings)
+
+	if err := setEffectiveSettings(d, m.(*Config), testId); err != nil {
+		return err
+	}
 
 	return nil
 }
 
+// omitBgpAlertExpressions clears the expressions setBgpAlertExpressions filled in, so that alert rules configured
+// without one read back the same. An expression is only cleared when a prior alert rule of its sub type had none and
+// no prior alert rule of that sub type set it explicitly
+func omitBgpAlertExpressions(alertSettings []interface{}, priorAlertSettings []interface{}, bgpSettings *BgpSettings) {
+	if bgpSettings == nil {
+		return
+	}
+	priorExpressions := map[string]map[string]bool{}
+	for _, priorAlertSetting := range priorAlertSettings {
+		for _, priorAlertRule := range priorAlertSetting.(map[string]interface{})["alert_rule"].(*schema.Set).List() {
+			priorAlertRuleMap := priorAlertRule.(map[string]interface{})
+			alertSubType := priorAlertRuleMap["alert_sub_type"].(string)
+			if priorExpressions[alertSubType] == nil {
+				priorExpressions[alertSubType] = map[string]bool{}
+			}
+			priorExpressions[alertSubType][priorAlertRuleMap["expression"].(string)] = true
+		}
+	}
+
+	for _, alertSetting := range alertSettings {
+		for _, alertRule := range alertSetting.(map[string]interface{})["alert_rule"].([]interface{}) {
+			alertRuleMap := alertRule.(map[string]interface{})
+			alertSubType, _ := alertRuleMap["alert_sub_type"].(string)
+			expression := getBgpAlertExpression(alertSubType, bgpSettings.Prefixes, bgpSettings.OriginAsns, bgpSettings.UpstreamNeighbors)
+			if expression == "" || alertRuleMap["expression"] != expression {
+				continue
+			}
+			if priorExpressions[alertSubType][""] && !priorExpressions[alertSubType][expression] {
+				alertRuleMap["expression"] = ""
+			}
+		}
+	}
+}
+
 func resourceBgpTestUpdate(d *schema.ResourceData, m interface{}) error {
 	testId := d.Id()
 	api_token := m.(*Config).ApiToken
+	jsonPatchDocs, err := buildBgpTestJsonPatchDocs(d, m)
+	if err != nil {
+		return err
+	}
+
+	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
+
+	if jsonPatchDoc != "[]" {
+		log.Printf("[DEBUG] Updating test: %v", testId)
+		if m.(*Config).LogJson {
+			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
+		}
+		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !completed {
+			log.Printf("[ERROR] Error while Updating test: %v", testId)
+			log.Printf("[ERROR] Error description: " + respBody)
+			return errors.New(respBody)
+		}
+		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+		log.Print(respBody)
+
+		return resourceBgpTestRead(d, m)
+	} else {
+		return errors.New("no changes. Your infrastructure matches the configuration")
+	}
+}
+
+// buildBgpTestJsonPatchDocs builds the JSON Patch operations for the attributes of a bgp test that changed
+func buildBgpTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
 	test_type := TestType(Bgp)
 	var testConfig = TestConfig{}
 	var jsonPatchDocs = []string{}
@@ -485,15 +622,17 @@ func resourceBgpTestUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	if d.HasChange("monitor") {
 		monitor := d.Get("monitor").(string)
-		monitor_id := getMonitorId(monitor)
+		monitor_id := getMonitorId(m.(*Config), monitor)
 		testConfigUpdate := TestConfigUpdate{
 			UpdatedFieldValue: strconv.Itoa(monitor_id),
 		}
 		jsonPatchDocs = append(jsonPatchDocs, createJsonPatchDocument(testConfigUpdate, "/monitor", true))
 	}
-	if d.HasChange("prefix") {
+	prefixes := getBgpTestPrefixes(d)
+	setBgpSettings(int(test_type), prefixes, d.Get("expected_origin_asns").([]interface{}), d.Get("expected_upstream_neighbors").([]interface{}), &testConfig)
+	if d.HasChanges("prefix", "prefixes") {
 		testConfigUpdate := TestConfigUpdate{
-			UpdatedFieldValue: d.Get("prefix").(string),
+			UpdatedFieldValue: prefixes[0],
 		}
 		jsonPatchDocs = append(jsonPatchDocs, createJsonPatchDocument(testConfigUpdate, "/url", true))
 	}
@@ -535,6 +674,14 @@ func resourceBgpTestUpdate(d *schema.ResourceData, m interface{}) error {
 		jsonPatchDocs = append(jsonPatchDocs, createJsonPatchDocument(testConfigUpdate, "/status", true))
 	}
 
+	if d.HasChanges("prefix", "prefixes", "expected_origin_asns", "expected_upstream_neighbors") {
+		testConfigUpdate := TestConfigUpdate{
+			UpdatedBgpSettingsSection: setTestBgpSettings(&testConfig),
+			SectionToUpdate:           "/bgpSettings",
+		}
+		jsonPatchDocs = append(jsonPatchDocs, createJsonPatchDocument(testConfigUpdate, testConfigUpdate.SectionToUpdate, false))
+	}
+
 	if d.HasChange("label") {
 		label, labelOk := d.GetOk("label")
 		if labelOk {
@@ -550,16 +697,18 @@ func resourceBgpTestUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	if d.HasChange("alert_settings") {
+	// The expressions alert rules default to follow the prefixes, origin ASNs and upstream neighbors
+	if d.HasChanges("alert_settings", "prefix", "prefixes", "expected_origin_asns", "expected_upstream_neighbors") {
 		alert_settings, alert_settingsOk := d.GetOk("alert_settings")
 		if alert_settingsOk {
 			alert_setting_list := alert_settings.(*schema.Set).List()
 			alert_setting := alert_setting_list[0].(map[string]interface{})
 
-			err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+			err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
+			setBgpAlertExpressions(&testConfig)
 
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedAlertSettingsSection: setTestAlertSettings(&testConfig),
@@ -569,29 +718,7 @@ func resourceBgpTestUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
-
-	if jsonPatchDoc != "[]" {
-		log.Printf("[DEBUG] Updating test: %v", testId)
-		if m.(*Config).LogJson {
-			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
-		}
-		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
-		if err != nil {
-			log.Fatal(err)
-		}
-		if !completed {
-			log.Printf("[ERROR] Error while Updating test: %v", testId)
-			log.Printf("[ERROR] Error description: " + respBody)
-			return errors.New(respBody)
-		}
-		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-		log.Print(respBody)
-
-		return resourceBgpTestRead(d, m)
-	} else {
-		return errors.New("no changes. Your infrastructure matches the configuration")
-	}
+	return jsonPatchDocs, nil
 }
 
 func resourceBgpTestDelete(d *schema.ResourceData, m interface{}) error {
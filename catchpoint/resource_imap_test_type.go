@@ -0,0 +1,28 @@
+package catchpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var imapTest = mailTestType{
+	testType:          TestType(Imap),
+	monitor:           "imap",
+	scheme:            "imap",
+	defaultPort:       143,
+	retrievesMessages: true,
+	hasMailbox:        true,
+}
+
+func resourceImapTestType() *schema.Resource {
+	return resourceMailTestType(imapTest)
+}
+
+// buildImapTestConfig builds the configuration of a new imap test from the resource data
+func buildImapTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
+	return buildMailTestConfig(imapTest, d, m)
+}
+
+// buildImapTestJsonPatchDocs builds the JSON Patch operations for the attributes of a imap test that changed
+func buildImapTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
+	return buildMailTestJsonPatchDocs(imapTest, d, m)
+}
@@ -0,0 +1,202 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceIndicator() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIndicatorCreate,
+		Read:   resourceIndicatorRead,
+		Update: resourceIndicatorUpdate,
+		Delete: resourceIndicatorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the indicator will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the indicator",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The indicator description",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Where the value is captured from: 'request header', 'response header', 'cookie', 'response body', 'url'",
+				ValidateFunc: validation.StringInSlice([]string{"request header", "response header", "cookie", "response body", "url"}, false),
+			},
+			"source_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. Name of the header or cookie to capture. Required if source is 'request header', 'response header' or 'cookie'",
+			},
+			"expression": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Regular expression applied to the source. The first capture group must match a number and is stored as the indicator value",
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "integer",
+				Description:  "Optional. Data type of the captured metric: 'integer', 'decimal'. Defaults to 'integer'",
+				ValidateFunc: validation.StringInSlice([]string{"integer", "decimal"}, false),
+			},
+		},
+	}
+}
+
+func resourceIndicatorCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	indicator, err := setIndicator(d)
+	if err != nil {
+		return err
+	}
+	indicatorJson, _ := json.Marshal(indicator)
+	jsonStr := string(indicatorJson)
+
+	if m.(*Config).LogJson {
+		log.Printf("[INDICATOR JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating indicator: " + name)
+	respBody, respStatus, indicatorId, err := createIndicator(api_token, jsonStr)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating indicator: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(indicatorId)
+	return resourceIndicatorRead(d, m)
+}
+
+func resourceIndicatorRead(d *schema.ResourceData, m interface{}) error {
+	indicatorId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching indicator: %v", indicatorId)
+
+	indicator, respStatus, err := getIndicator(api_token, indicatorId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading indicator: %v", indicatorId)
+		return errors.New(respStatus)
+	}
+	if indicator == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Indicator not found %v", indicatorId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", indicator.DivisionId)
+	d.Set("name", indicator.Name)
+	d.Set("description", indicator.Description)
+	d.Set("source", getCaptureSourceName(indicator.Capture.Source.Id))
+	d.Set("source_name", indicator.Capture.SourceName)
+	d.Set("expression", indicator.Capture.Expression)
+	d.Set("data_type", getInsightDataTypeName(indicator.DataType.Id))
+
+	return nil
+}
+
+func resourceIndicatorUpdate(d *schema.ResourceData, m interface{}) error {
+	indicatorId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	indicator, err := setIndicator(d)
+	if err != nil {
+		return err
+	}
+	indicatorJson, _ := json.Marshal(indicator)
+	jsonStr := string(indicatorJson)
+
+	log.Printf("[DEBUG] Updating indicator: %v", indicatorId)
+	if m.(*Config).LogJson {
+		log.Printf("[DEBUG] Updating indicator with JSON: %v", jsonStr)
+	}
+	respBody, respStatus, completed, err := updateIndicator(api_token, indicatorId, jsonStr)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating indicator: %v", indicatorId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceIndicatorRead(d, m)
+}
+
+func resourceIndicatorDelete(d *schema.ResourceData, m interface{}) error {
+	indicatorId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting indicator: %v", indicatorId)
+	respBody, respStatus, completed, err := deleteIndicator(api_token, indicatorId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting indicator: %v", indicatorId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setIndicator(d *schema.ResourceData) (*Indicator, error) {
+	capture, err := setInsightCapture(d)
+	if err != nil {
+		return nil, err
+	}
+	data_type := d.Get("data_type").(string)
+	data_type_id, data_type_name := getInsightDataTypeId(data_type)
+	if data_type_id == -1 || data_type == "string" {
+		return nil, errors.New("invalid data type string provided. acceptable values are integer and decimal")
+	}
+
+	indicator := Indicator{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Capture:     capture,
+		DataType:    GenericIdName{Id: data_type_id, Name: data_type_name},
+	}
+
+	return &indicator, nil
+}
@@ -0,0 +1,182 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLibraryCertificate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLibraryCertificateCreate,
+		Read:   resourceLibraryCertificateRead,
+		Update: resourceLibraryCertificateUpdate,
+		Delete: resourceLibraryCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the certificate will be stored",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the certificate in the credential library",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The certificate description",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The PEM encoded client certificate. Write-only: it is never read back from the API. An imported resource has no value in state, so the first apply after import rewrites it unless it is listed in lifecycle ignore_changes",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate. Write-only: it is never read back from the API, so changing it here rotates the stored key. An imported resource has no value in state, so the first apply after import rewrites it unless it is listed in lifecycle ignore_changes",
+			},
+			"pass_phrase": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Optional. Pass phrase protecting the private key. Write-only: it is never read back from the API",
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Subject of the stored certificate as reported by Catchpoint",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the stored certificate as reported by Catchpoint",
+			},
+		},
+	}
+}
+
+func resourceLibraryCertificateCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	certificate := setLibraryCertificate(d)
+	certificateJson := marshalSecretPayload(certificate)
+
+	log.Printf("[DEBUG] Creating library certificate: " + name)
+	respBody, respStatus, certificateId, err := createLibraryCertificate(api_token, certificateJson)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating library certificate: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(certificateId)
+	return resourceLibraryCertificateRead(d, m)
+}
+
+func resourceLibraryCertificateRead(d *schema.ResourceData, m interface{}) error {
+	certificateId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching library certificate: %v", certificateId)
+
+	certificate, respStatus, err := getLibraryCertificate(api_token, certificateId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading library certificate: %v", certificateId)
+		return errors.New(respStatus)
+	}
+	if certificate == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Library certificate not found %v", certificateId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", certificate.DivisionId)
+	d.Set("name", certificate.Name)
+	d.Set("description", certificate.Description)
+	d.Set("subject", certificate.Subject)
+	d.Set("expiration_date", certificate.ExpirationDate)
+
+	return nil
+}
+
+func resourceLibraryCertificateUpdate(d *schema.ResourceData, m interface{}) error {
+	certificateId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	certificate := setLibraryCertificate(d)
+	// Only send the key material when it changed so unrelated updates do not rotate it
+	if !d.HasChanges("certificate", "private_key", "pass_phrase") {
+		certificate.Certificate = ""
+		certificate.PrivateKey = ""
+		certificate.PassPhrase = ""
+	}
+	certificateJson := marshalSecretPayload(certificate)
+
+	log.Printf("[DEBUG] Updating library certificate: %v", certificateId)
+	respBody, respStatus, completed, err := updateLibraryCertificate(api_token, certificateId, certificateJson)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating library certificate: %v", certificateId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceLibraryCertificateRead(d, m)
+}
+
+func resourceLibraryCertificateDelete(d *schema.ResourceData, m interface{}) error {
+	certificateId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting library certificate: %v", certificateId)
+	respBody, respStatus, completed, err := deleteLibraryCertificate(api_token, certificateId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting library certificate: %v", certificateId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setLibraryCertificate(d *schema.ResourceData) *LibraryCertificate {
+	return &LibraryCertificate{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Certificate: d.Get("certificate").(string),
+		PrivateKey:  d.Get("private_key").(string),
+		PassPhrase:  d.Get("pass_phrase").(string),
+	}
+}
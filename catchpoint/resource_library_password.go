@@ -0,0 +1,162 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLibraryPassword() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLibraryPasswordCreate,
+		Read:   resourceLibraryPasswordRead,
+		Update: resourceLibraryPasswordUpdate,
+		Delete: resourceLibraryPasswordDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the password will be stored",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the password in the credential library",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The password description",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The username used together with the password",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret password. Write-only: it is never read back from the API, so changing it here rotates the stored password. An imported resource has no value in state, so the first apply after import rewrites it unless it is listed in lifecycle ignore_changes",
+			},
+		},
+	}
+}
+
+func resourceLibraryPasswordCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	password := setLibraryPassword(d)
+	passwordJson := marshalSecretPayload(password)
+
+	log.Printf("[DEBUG] Creating library password: " + name)
+	respBody, respStatus, passwordId, err := createLibraryPassword(api_token, passwordJson)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating library password: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(passwordId)
+	return resourceLibraryPasswordRead(d, m)
+}
+
+func resourceLibraryPasswordRead(d *schema.ResourceData, m interface{}) error {
+	passwordId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching library password: %v", passwordId)
+
+	password, respStatus, err := getLibraryPassword(api_token, passwordId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading library password: %v", passwordId)
+		return errors.New(respStatus)
+	}
+	if password == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Library password not found %v", passwordId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", password.DivisionId)
+	d.Set("name", password.Name)
+	d.Set("description", password.Description)
+	d.Set("username", password.Username)
+
+	return nil
+}
+
+func resourceLibraryPasswordUpdate(d *schema.ResourceData, m interface{}) error {
+	passwordId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	password := setLibraryPassword(d)
+	// Only send the secret when it changed so unrelated updates do not rotate it
+	if !d.HasChange("password") {
+		password.Password = ""
+	}
+	passwordJson := marshalSecretPayload(password)
+
+	log.Printf("[DEBUG] Updating library password: %v", passwordId)
+	respBody, respStatus, completed, err := updateLibraryPassword(api_token, passwordId, passwordJson)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating library password: %v", passwordId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceLibraryPasswordRead(d, m)
+}
+
+func resourceLibraryPasswordDelete(d *schema.ResourceData, m interface{}) error {
+	passwordId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting library password: %v", passwordId)
+	respBody, respStatus, completed, err := deleteLibraryPassword(api_token, passwordId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting library password: %v", passwordId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setLibraryPassword(d *schema.ResourceData) *LibraryPassword {
+	return &LibraryPassword{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Username:    d.Get("username").(string),
+		Password:    d.Get("password").(string),
+	}
+}
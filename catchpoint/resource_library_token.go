@@ -0,0 +1,155 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceLibraryToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLibraryTokenCreate,
+		Read:   resourceLibraryTokenRead,
+		Update: resourceLibraryTokenUpdate,
+		Delete: resourceLibraryTokenDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the token will be stored",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the token in the credential library",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The token description",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret token value. Write-only: it is never read back from the API, so changing it here rotates the stored token. An imported resource has no value in state, so the first apply after import rewrites it unless it is listed in lifecycle ignore_changes",
+			},
+		},
+	}
+}
+
+func resourceLibraryTokenCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	token := setLibraryToken(d)
+	tokenJson := marshalSecretPayload(token)
+
+	log.Printf("[DEBUG] Creating library token: " + name)
+	respBody, respStatus, tokenId, err := createLibraryToken(api_token, tokenJson)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating library token: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(tokenId)
+	return resourceLibraryTokenRead(d, m)
+}
+
+func resourceLibraryTokenRead(d *schema.ResourceData, m interface{}) error {
+	tokenId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching library token: %v", tokenId)
+
+	token, respStatus, err := getLibraryToken(api_token, tokenId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading library token: %v", tokenId)
+		return errors.New(respStatus)
+	}
+	if token == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Library token not found %v", tokenId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", token.DivisionId)
+	d.Set("name", token.Name)
+	d.Set("description", token.Description)
+
+	return nil
+}
+
+func resourceLibraryTokenUpdate(d *schema.ResourceData, m interface{}) error {
+	tokenId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	token := setLibraryToken(d)
+	// Only send the secret when it changed so unrelated updates do not rotate it
+	if !d.HasChange("value") {
+		token.Value = ""
+	}
+	tokenJson := marshalSecretPayload(token)
+
+	log.Printf("[DEBUG] Updating library token: %v", tokenId)
+	respBody, respStatus, completed, err := updateLibraryToken(api_token, tokenId, tokenJson)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating library token: %v", tokenId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceLibraryTokenRead(d, m)
+}
+
+func resourceLibraryTokenDelete(d *schema.ResourceData, m interface{}) error {
+	tokenId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting library token: %v", tokenId)
+	respBody, respStatus, completed, err := deleteLibraryToken(api_token, tokenId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting library token: %v", tokenId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setLibraryToken(d *schema.ResourceData) *LibraryToken {
+	return &LibraryToken{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Value:       d.Get("value").(string),
+	}
+}
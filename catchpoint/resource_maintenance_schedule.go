@@ -0,0 +1,317 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceMaintenanceSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMaintenanceScheduleCreate,
+		Read:   resourceMaintenanceScheduleRead,
+		Update: resourceMaintenanceScheduleUpdate,
+		Delete: resourceMaintenanceScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the maintenance schedule will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the maintenance schedule",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The maintenance schedule description",
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The time zone the maintenance window is evaluated in. Example: UTC or America/New_York",
+			},
+			"start_time": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Start time of the first maintenance window in ISO format like 2024-12-30T04:59:00Z",
+			},
+			"duration": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Duration of each maintenance window in minutes",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"recurrence": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Optional. Repeats the maintenance window weekly or monthly. Omit for a one time window",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"frequency": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Sets the recurrence frequency: 'weekly' or 'monthly'",
+							ValidateFunc: validation.StringInSlice([]string{"weekly", "monthly"}, false),
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							Description:  "Optional. Repeats the window every N weeks or months. Defaults to 1",
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"days_of_week": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Optional. Days the window recurs on: 'sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday'. Required for weekly recurrence",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}, false),
+							},
+						},
+						"day_of_month": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Description:  "Optional. Day of the month the window recurs on for monthly recurrence",
+							ValidateFunc: validation.IntBetween(1, 31),
+						},
+						"week_of_month": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Optional. Week of the month used together with days_of_week for monthly recurrence: 'first', 'second', 'third', 'fourth', 'last'",
+							ValidateFunc: validation.StringInSlice([]string{"first", "second", "third", "fourth", "last"}, false),
+						},
+						"end_time": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Optional. Time after which the window no longer recurs in ISO format like 2024-12-30T04:59:00Z",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMaintenanceScheduleCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	schedule, err := setMaintenanceSchedule(d)
+	if err != nil {
+		return err
+	}
+	scheduleJson, _ := json.Marshal(schedule)
+	jsonStr := string(scheduleJson)
+
+	if m.(*Config).LogJson {
+		log.Printf("[MAINTENANCE SCHEDULE JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating maintenance schedule: " + name)
+	respBody, respStatus, scheduleId, err := createMaintenanceSchedule(api_token, jsonStr)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating maintenance schedule: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(scheduleId)
+	return resourceMaintenanceScheduleRead(d, m)
+}
+
+func resourceMaintenanceScheduleRead(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching maintenance schedule: %v", scheduleId)
+
+	schedule, respStatus, err := getMaintenanceSchedule(api_token, scheduleId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading maintenance schedule: %v", scheduleId)
+		return errors.New(respStatus)
+	}
+	if schedule == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Maintenance schedule not found %v", scheduleId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", schedule.DivisionId)
+	d.Set("name", schedule.Name)
+	d.Set("description", schedule.Description)
+	d.Set("time_zone", schedule.TimeZone)
+	d.Set("start_time", schedule.StartTime)
+	d.Set("duration", schedule.Duration)
+	d.Set("recurrence", flattenMaintenanceScheduleRecurrence(schedule.Recurrence))
+
+	return nil
+}
+
+func resourceMaintenanceScheduleUpdate(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	schedule, err := setMaintenanceSchedule(d)
+	if err != nil {
+		return err
+	}
+	scheduleJson, _ := json.Marshal(schedule)
+	jsonStr := string(scheduleJson)
+
+	log.Printf("[DEBUG] Updating maintenance schedule: %v", scheduleId)
+	if m.(*Config).LogJson {
+		log.Printf("[DEBUG] Updating maintenance schedule with JSON: %v", jsonStr)
+	}
+	respBody, respStatus, completed, err := updateMaintenanceSchedule(api_token, scheduleId, jsonStr)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating maintenance schedule: %v", scheduleId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceMaintenanceScheduleRead(d, m)
+}
+
+func resourceMaintenanceScheduleDelete(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting maintenance schedule: %v", scheduleId)
+	respBody, respStatus, completed, err := deleteMaintenanceSchedule(api_token, scheduleId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting maintenance schedule: %v", scheduleId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setMaintenanceSchedule(d *schema.ResourceData) (*MaintenanceSchedule, error) {
+	schedule := MaintenanceSchedule{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		TimeZone:    d.Get("time_zone").(string),
+		StartTime:   d.Get("start_time").(string),
+		Duration:    d.Get("duration").(int),
+	}
+
+	schedule_type := "one time"
+	recurrences, recurrenceOk := d.GetOk("recurrence")
+	if recurrenceOk {
+		schedule_type = "recurring"
+		recurrence := recurrences.(*schema.Set).List()[0].(map[string]interface{})
+
+		frequency := recurrence["frequency"].(string)
+		frequency_id, frequency_name := getRecurrenceFrequencyId(frequency)
+		if frequency_id == -1 {
+			return nil, errors.New("invalid recurrence frequency string provided. acceptable values are weekly and monthly")
+		}
+
+		var daysOfWeek []GenericIdName
+		for _, tfday := range recurrence["days_of_week"].([]interface{}) {
+			day_id, day_name := getDayOfWeekId(tfday.(string))
+			if day_id == -1 {
+				return nil, errors.New("invalid day of week string provided. acceptable values are sunday, monday, tuesday, wednesday, thursday, friday and saturday")
+			}
+			daysOfWeek = append(daysOfWeek, GenericIdName{Id: day_id, Name: day_name})
+		}
+		day_of_month := recurrence["day_of_month"].(int)
+		week_of_month := recurrence["week_of_month"].(string)
+
+		if frequency == "weekly" && len(daysOfWeek) == 0 {
+			return nil, errors.New("must specify at least 1 day in days_of_week for weekly recurrence")
+		}
+		if frequency == "monthly" && day_of_month == 0 && (week_of_month == "" || len(daysOfWeek) == 0) {
+			return nil, errors.New("monthly recurrence requires either day_of_month or week_of_month together with days_of_week")
+		}
+
+		scheduleRecurrence := MaintenanceScheduleRecurrence{
+			Frequency:  GenericIdName{Id: frequency_id, Name: frequency_name},
+			Interval:   recurrence["interval"].(int),
+			DaysOfWeek: daysOfWeek,
+			DayOfMonth: day_of_month,
+			EndTime:    recurrence["end_time"].(string),
+		}
+		if week_of_month != "" {
+			week_of_month_id, week_of_month_name := getWeekOfMonthId(week_of_month)
+			if week_of_month_id == -1 {
+				return nil, errors.New("invalid week of month string provided. acceptable values are first, second, third, fourth and last")
+			}
+			scheduleRecurrence.WeekOfMonth = &GenericIdNameOmitEmpty{Id: week_of_month_id, Name: week_of_month_name}
+		}
+		schedule.Recurrence = &scheduleRecurrence
+	}
+
+	schedule_type_id, schedule_type_name := getMaintenanceScheduleTypeId(schedule_type)
+	schedule.ScheduleType = GenericIdName{Id: schedule_type_id, Name: schedule_type_name}
+
+	return &schedule, nil
+}
+
+func flattenMaintenanceScheduleRecurrence(recurrence *MaintenanceScheduleRecurrence) []interface{} {
+	if recurrence == nil {
+		return nil
+	}
+
+	daysOfWeek := make([]string, len(recurrence.DaysOfWeek))
+	for i, day := range recurrence.DaysOfWeek {
+		daysOfWeek[i] = getDayOfWeekName(day.Id)
+	}
+
+	// An omitted interval means the window repeats every week or month
+	interval := recurrence.Interval
+	if interval == 0 {
+		interval = 1
+	}
+
+	weekOfMonth := ""
+	if recurrence.WeekOfMonth != nil {
+		weekOfMonth = getWeekOfMonthName(recurrence.WeekOfMonth.Id)
+	}
+
+	recurrenceMap := map[string]interface{}{
+		"frequency":     getRecurrenceFrequencyName(recurrence.Frequency.Id),
+		"interval":      interval,
+		"days_of_week":  daysOfWeek,
+		"day_of_month":  recurrence.DayOfMonth,
+		"week_of_month": weekOfMonth,
+		"end_time":      recurrence.EndTime,
+	}
+	return []interface{}{recurrenceMap}
+}
@@ -19,14 +19,14 @@ func resourcePingTestType() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: validateEnumCatalogueDiff,
 
 		Schema: map[string]*schema.Schema{
 			"monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The monitor to use for the Ping Test. Supported: 'ping icmp','ping tcp','ping udp'",
-				Default:      "ping icmp",
-				ValidateFunc: validation.StringInSlice([]string{"ping icmp", "ping tcp", "ping udp"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The monitor to use for the Ping Test. Supported: 'ping icmp','ping tcp','ping udp'",
+				Default:     "ping icmp",
 			},
 			"division_id": {
 				Type:        schema.TypeInt,
@@ -63,8 +63,8 @@ func resourcePingTestType() *schema.Resource {
 			"enable_test_data_webhook": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
-				Description: "Optional. Switch for enabling test data webhook feature",
+				Default:     false,
+				Description: "Optional. Switch for streaming the test data to the division's test data webhook (see catchpoint_test_data_webhook). Defaults to false",
 			},
 			"alerts_paused": {
 				Type:        schema.TypeBool,
@@ -156,10 +156,9 @@ func resourcePingTestType() *schema.Resource {
 							Description: "Optional. The maintenance schedule id to utilize for the test",
 						},
 						"frequency": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
-							ValidateFunc: validation.StringInSlice([]string{"1 minute", "5 minutes", "10 minutes", "15 minutes", "20 minutes", "30 minutes", "60 minutes", "2 hours", "3 hours", "4 hours", "6 hours", "8 hours", "12 hours", "24 hours", "4 minutes", "2 minutes"}, false),
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
 						},
 						"node_distribution": {
 							Type:         schema.TypeString,
@@ -277,16 +276,14 @@ func resourcePingTestType() *schema.Resource {
 										Description: "Optional. Sets trigger expression for content match alert type ",
 									},
 									"warning_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"critical_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"threshold_interval": {
 										Type:         schema.TypeString,
@@ -313,10 +310,9 @@ func resourcePingTestType() *schema.Resource {
 										ValidateFunc: validation.StringInSlice([]string{"test failure", "ping"}, false),
 									},
 									"alert_sub_type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets the sub alert type: 'ping rtt','ping packet loss'",
-										ValidateFunc: validation.StringInSlice([]string{"ping rtt", "ping packet loss"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets the sub alert type: 'ping rtt','ping packet loss'",
 									},
 									"enforce_test_failure": {
 										Type:        schema.TypeBool,
@@ -471,8 +467,40 @@ func resourcePingTestType() *schema.Resource {
 
 func resourcePingTestCreate(d *schema.ResourceData, m interface{}) error {
 	api_token := m.(*Config).ApiToken
+	testConfig, err := buildPingTestConfig(d, m)
+	if err != nil {
+		return err
+	}
+	test_name := testConfig.TestName
+
+	jsonStr := createJson(testConfig)
+
+	if m.(*Config).LogJson {
+		log.Printf("[TEST JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating test: " + test_name)
+	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating test: " + test_name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(testId)
+	return resourcePingTestRead(d, m)
+}
+
+// buildPingTestConfig builds the configuration of a new ping test from the resource data
+func buildPingTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
 	monitor := d.Get("monitor").(string)
-	monitor_id := getMonitorId(monitor)
+	monitor_id := getMonitorId(m.(*Config), monitor)
 	division_id := d.Get("division_id").(int)
 	product_id := d.Get("product_id").(int)
 	folder_id := d.Get("folder_id").(int)
@@ -528,9 +556,9 @@ func resourcePingTestCreate(d *schema.ResourceData, m interface{}) error {
 		schedule_setting_list := schedule_settings.(*schema.Set).List()
 		schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-		err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+		err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -539,9 +567,9 @@ func resourcePingTestCreate(d *schema.ResourceData, m interface{}) error {
 		alert_setting_list := alert_settings.(*schema.Set).List()
 		alert_setting := alert_setting_list[0].(map[string]interface{})
 
-		err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+		err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -550,31 +578,10 @@ func resourcePingTestCreate(d *schema.ResourceData, m interface{}) error {
 		advanced_setting_list := advanced_settings.(*schema.Set).List()
 		advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-		setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
+		setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 	}
 
-	jsonStr := createJson(testConfig)
-
-	if m.(*Config).LogJson {
-		log.Printf("[TEST JSON] \n" + jsonStr)
-	}
-
-	log.Printf("[DEBUG] Creating test: " + test_name)
-	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
-	if err != nil {
-		log.Fatal(err)
-	}
-	if respStatus != "200 ok" {
-		log.Printf("[ERROR] Error while creating test: " + test_name)
-		log.Printf("[ERROR] Error description: " + respBody)
-		return errors.New(respStatus)
-	}
-
-	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-	log.Print(respBody)
-
-	d.SetId(testId)
-	return resourcePingTestRead(d, m)
+	return testConfig, nil
 }
 
 func resourcePingTestRead(d *schema.ResourceData, m interface{}) error {
@@ -598,7 +605,7 @@ func resourcePingTestRead(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
 
-	testNew := flattenTest(test)
+	testNew := flattenTest(m.(*Config), test)
 
 	d.Set("monitor", testNew["monitor"])
 	d.Set("division_id", testNew["division_id"])
@@ -618,12 +625,48 @@ func resourcePingTestRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("alert_settings", testNew["alert_settings"])
 	d.Set("advanced_settings", testNew["advanced_settings"])
 
+	if err := setEffectiveSettings(d, m.(*Config), testId); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 	testId := d.Id()
 	api_token := m.(*Config).ApiToken
+	jsonPatchDocs, err := buildPingTestJsonPatchDocs(d, m)
+	if err != nil {
+		return err
+	}
+
+	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
+
+	if jsonPatchDoc != "[]" {
+		log.Printf("[DEBUG] Updating test: %v", testId)
+		if m.(*Config).LogJson {
+			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
+		}
+		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !completed {
+			log.Printf("[ERROR] Error while Updating test: %v", testId)
+			log.Printf("[ERROR] Error description: " + respBody)
+			return errors.New(respBody)
+		}
+		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+		log.Print(respBody)
+
+		return resourcePingTestRead(d, m)
+	} else {
+		return errors.New("no changes. Your infrastructure matches the configuration")
+	}
+}
+
+// buildPingTestJsonPatchDocs builds the JSON Patch operations for the attributes of a ping test that changed
+func buildPingTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
 	test_type := TestType(Ping)
 	var testConfig = TestConfig{}
 	var jsonPatchDocs = []string{}
@@ -642,7 +685,7 @@ func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	if d.HasChange("monitor") {
 		monitor := d.Get("monitor").(string)
-		monitor_id := getMonitorId(monitor)
+		monitor_id := getMonitorId(m.(*Config), monitor)
 		testConfigUpdate := TestConfigUpdate{
 			UpdatedFieldValue: strconv.Itoa(monitor_id),
 		}
@@ -723,7 +766,7 @@ func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 			advanced_setting_list := advanced_settings.(*schema.Set).List()
 			advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-			setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
+			setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedAdvancedSettingsSection: setTestAdvancedSettings(&testConfig),
@@ -739,9 +782,9 @@ func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 			schedule_setting_list := schedule_settings.(*schema.Set).List()
 			schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-			err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+			err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -758,9 +801,9 @@ func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 			alert_setting_list := alert_settings.(*schema.Set).List()
 			alert_setting := alert_setting_list[0].(map[string]interface{})
 
-			err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+			err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -771,29 +814,7 @@ func resourcePingTestUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
-
-	if jsonPatchDoc != "[]" {
-		log.Printf("[DEBUG] Updating test: %v", testId)
-		if m.(*Config).LogJson {
-			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
-		}
-		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
-		if err != nil {
-			log.Fatal(err)
-		}
-		if !completed {
-			log.Printf("[ERROR] Error while Updating test: %v", testId)
-			log.Printf("[ERROR] Error description: " + respBody)
-			return errors.New(respBody)
-		}
-		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-		log.Print(respBody)
-
-		return resourcePingTestRead(d, m)
-	} else {
-		return errors.New("no changes. Your infrastructure matches the configuration")
-	}
+	return jsonPatchDocs, nil
 }
 
 func resourcePingTestDelete(d *schema.ResourceData, m interface{}) error {
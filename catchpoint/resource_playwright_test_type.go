@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
@@ -19,14 +20,14 @@ func resourcePlaywrightTestType() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customdiff.All(validateChromeVersionDiff, validateEnumCatalogueDiff),
 
 		Schema: map[string]*schema.Schema{
 			"monitor": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Description:  "The monitor to use for the Playwright Test. Supported: 'playwright', 'chrome'",
-				Default:      "playwright",
-				ValidateFunc: validation.StringInSlice([]string{"playwright", "chrome"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The monitor to use for the Playwright Test. Supported: 'playwright', 'chrome'",
+				Default:     "playwright",
 			},
 			"simulate": {
 				Type:         schema.TypeString,
@@ -36,11 +37,10 @@ func resourcePlaywrightTestType() *schema.Resource {
 				ValidateFunc: validation.StringInSlice([]string{"android", "iphone", "ipad 2", "kindle fire", "galaxy tab", "iphone 5", "ipad mini", "galaxy note", "nexus 7", "nexus 4", "nokia lumia920", "iphone 6", "blackberry z30", "galaxy s4", "htc onex", "lg optimusg", "droid razr hd", "nexus 6", "iphone 6s", "galaxy s6", "iphone 7", "google pixel", "galaxy s8"}, false),
 			},
 			"chrome_version": {
-				Type:         schema.TypeString,
-				Optional:     true,
-				Computed:     true,
-				Description:  "Optional. Chrome version to use. Supported: 'preview', 'stable', '108', '89', '87', '85', '75', '71', '66', '63', '59', '53'",
-				ValidateFunc: validation.StringInSlice([]string{"preview", "stable", "108", "89", "87", "85", "75", "71", "66", "63", "59", "53"}, false),
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Optional. Chrome version to use: 'preview', 'stable' or a specific version listed by the catchpoint_chrome_versions data source, like '108'",
 			},
 			"division_id": {
 				Type:        schema.TypeInt,
@@ -90,8 +90,8 @@ func resourcePlaywrightTestType() *schema.Resource {
 			"enable_test_data_webhook": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Default:     true,
-				Description: "Optional. Switch for enabling test data webhook feature",
+				Default:     false,
+				Description: "Optional. Switch for streaming the test data to the division's test data webhook (see catchpoint_test_data_webhook). Defaults to false",
 			},
 			"alerts_paused": {
 				Type:        schema.TypeBool,
@@ -515,10 +515,9 @@ func resourcePlaywrightTestType() *schema.Resource {
 							Description: "Optional. The maintenance schedule id to utilize for the test",
 						},
 						"frequency": {
-							Type:         schema.TypeString,
-							Required:     true,
-							Description:  "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
-							ValidateFunc: validation.StringInSlice([]string{"1 minute", "5 minutes", "10 minutes", "15 minutes", "20 minutes", "30 minutes", "60 minutes", "2 hours", "3 hours", "4 hours", "6 hours", "8 hours", "12 hours", "24 hours", "4 minutes", "2 minutes"}, false),
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Sets the scheduling frequency: '1 minute', '5 minutes', '10 minutes', '15 minutes', '20 minutes', '30 minutes', '60 minutes', '2 hours', '3 hours', '4 hours', '6 hours', '8 hours', '12 hours', '24 hours', '4 minutes', '2 minutes'",
 						},
 						"node_distribution": {
 							Type:         schema.TypeString,
@@ -636,16 +635,14 @@ func resourcePlaywrightTestType() *schema.Resource {
 										Description: "Optional. Sets trigger expression for content match alert type ",
 									},
 									"warning_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert warning reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"critical_reminder": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
-										ValidateFunc: validation.StringInSlice([]string{"none", "1 minute", "5 minutes", "10 minutes", "15 minutes", "30 minutes", "1 hour", "daily"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets alert critical reminder interval: 'none', '1 minute', '5 minutes', '10 minutes', '15 minutes', '30 minutes', '1 hour', 'daily'",
 									},
 									"threshold_interval": {
 										Type:         schema.TypeString,
@@ -672,10 +669,9 @@ func resourcePlaywrightTestType() *schema.Resource {
 										ValidateFunc: validation.StringInSlice([]string{"test failure", "timing", "availability", "host failure", "requests", "content match", "byte length"}, false),
 									},
 									"alert_sub_type": {
-										Type:         schema.TypeString,
-										Optional:     true,
-										Description:  "Optional. Sets the sub alert type: 'dns', 'connect', 'send', 'wait', 'load', 'ttfb', 'content load', 'response', 'test time', 'dom load', 'test time with suspect', 'server response', 'document complete', 'redirect', 'test', 'content', '% downtime'",
-										ValidateFunc: validation.StringInSlice([]string{"dns", "connect", "send", "wait", "load", "ttfb", "content load", "response", "test time", "dom load", "test time with suspect", "server response", "document complete", "redirect", "test", "content", "% downtime", "# requests", "# hosts", "# connections", "# redirects", "# other", "# images", "# scripts", "# html", "# css", "# xml", "# flash", "# media", "regular expression", "response code", "response headers", "byte length", "page", "file size"}, false),
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Optional. Sets the sub alert type: 'dns', 'connect', 'send', 'wait', 'load', 'ttfb', 'content load', 'response', 'test time', 'dom load', 'test time with suspect', 'server response', 'document complete', 'redirect', 'test', 'content', '% downtime'",
 									},
 									"enforce_test_failure": {
 										Type:        schema.TypeBool,
@@ -942,8 +938,40 @@ func resourcePlaywrightTestType() *schema.Resource {
 
 func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 	api_token := m.(*Config).ApiToken
+	testConfig, err := buildPlaywrightTestConfig(d, m)
+	if err != nil {
+		return err
+	}
+	test_name := testConfig.TestName
+
+	jsonStr := createJson(testConfig)
+
+	if m.(*Config).LogJson {
+		log.Printf("[TEST JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating test: " + test_name)
+	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating test: " + test_name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(testId)
+	return resourcePlaywrightTestRead(d, m)
+}
+
+// buildPlaywrightTestConfig builds the configuration of a new playwright test from the resource data
+func buildPlaywrightTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
 	monitor := d.Get("monitor").(string)
-	monitor_id := getMonitorId(monitor)
+	monitor_id := getMonitorId(m.(*Config), monitor)
 	simulate_device := d.Get("simulate").(string)
 	simulate_device_id := getUserAgentTypeId(simulate_device)
 	chrome_version := d.Get("chrome_version").(string)
@@ -951,7 +979,7 @@ func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 	var application_version_id int
 	var application_version_name string
 	if chrome_version_id == 3 {
-		application_version_id, application_version_name = getChromeApplicationVersionId(chrome_version)
+		application_version_id, application_version_name = getChromeApplicationVersionId(getTestChromeVersions(m), chrome_version)
 	}
 	if monitor == "chrome" && chrome_version == "" {
 		//default id 1 : stable for chrome monitor if chrome version attribute is not set
@@ -1025,7 +1053,7 @@ func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 
 		err := setRequestSettings(int(test_type), request_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1042,9 +1070,9 @@ func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 		schedule_setting_list := schedule_settings.(*schema.Set).List()
 		schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-		err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+		err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1053,9 +1081,9 @@ func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 		alert_setting_list := alert_settings.(*schema.Set).List()
 		alert_setting := alert_setting_list[0].(map[string]interface{})
 
-		err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+		err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 		if err != nil {
-			return err
+			return TestConfig{}, err
 		}
 	}
 
@@ -1064,31 +1092,10 @@ func resourcePlaywrightTestCreate(d *schema.ResourceData, m interface{}) error {
 		advanced_setting_list := advanced_settings.(*schema.Set).List()
 		advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-		setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
-	}
-
-	jsonStr := createJson(testConfig)
-
-	if m.(*Config).LogJson {
-		log.Printf("[TEST JSON] \n" + jsonStr)
-	}
-
-	log.Printf("[DEBUG] Creating test: " + test_name)
-	respBody, respStatus, testId, err := createTest(api_token, jsonStr)
-	if err != nil {
-		log.Fatal(err)
+		setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 	}
-	if respStatus != "200 ok" {
-		log.Printf("[ERROR] Error while creating test: " + test_name)
-		log.Printf("[ERROR] Error description: " + respBody)
-		return errors.New(respStatus)
-	}
-
-	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-	log.Print(respBody)
 
-	d.SetId(testId)
-	return resourcePlaywrightTestRead(d, m)
+	return testConfig, nil
 }
 
 func resourcePlaywrightTestRead(d *schema.ResourceData, m interface{}) error {
@@ -1112,11 +1119,11 @@ func resourcePlaywrightTestRead(d *schema.ResourceData, m interface{}) error {
 	}
 	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
 
-	testNew := flattenTest(test)
+	testNew := flattenTest(m.(*Config), test)
 
 	d.Set("monitor", testNew["monitor"])
 	d.Set("simulate", testNew["simulate"])
-	d.Set("chrome_version", testNew["chrome_version"])
+	d.Set("chrome_version", getTestChromeVersionName(test, m))
 	d.Set("division_id", testNew["division_id"])
 	d.Set("product_id", testNew["product_id"])
 	d.Set("folder_id", testNew["folder_id"])
@@ -1138,12 +1145,48 @@ func resourcePlaywrightTestRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("alert_settings", testNew["alert_settings"])
 	d.Set("advanced_settings", testNew["advanced_settings"])
 
+	if err := setEffectiveSettings(d, m.(*Config), testId); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 	testId := d.Id()
 	api_token := m.(*Config).ApiToken
+	jsonPatchDocs, err := buildPlaywrightTestJsonPatchDocs(d, m)
+	if err != nil {
+		return err
+	}
+
+	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
+
+	if jsonPatchDoc != "[]" {
+		log.Printf("[DEBUG] Updating test: %v", testId)
+		if m.(*Config).LogJson {
+			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
+		}
+		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if !completed {
+			log.Printf("[ERROR] Error while Updating test: %v", testId)
+			log.Printf("[ERROR] Error description: " + respBody)
+			return errors.New(respBody)
+		}
+		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+		log.Print(respBody)
+
+		return resourcePlaywrightTestRead(d, m)
+	} else {
+		return errors.New("no changes. Your infrastructure matches the configuration")
+	}
+}
+
+// buildPlaywrightTestJsonPatchDocs builds the JSON Patch operations for the attributes of a playwright test that changed
+func buildPlaywrightTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
 	test_type := TestType(Playwright)
 	var testConfig = TestConfig{}
 	var jsonPatchDocs = []string{}
@@ -1165,7 +1208,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 		chrome_version_id, _ := getChromeVersionId(chrome_version)
 		// Specific chrome version was provided
 		if chrome_version_id == 3 {
-			application_version_id, _ := getChromeApplicationVersionId(chrome_version)
+			application_version_id, _ := getChromeApplicationVersionId(getTestChromeVersions(m), chrome_version)
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedFieldValue: strconv.Itoa(application_version_id),
 			}
@@ -1180,7 +1223,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 	if d.HasChange("monitor") {
 		monitor := d.Get("monitor").(string)
-		monitor_id := getMonitorId(monitor)
+		monitor_id := getMonitorId(m.(*Config), monitor)
 		testConfigUpdate := TestConfigUpdate{
 			UpdatedFieldValue: strconv.Itoa(monitor_id),
 		}
@@ -1226,7 +1269,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 
 	if d.HasChange("test_script") {
 		test_script_type_id := getApiScriptTypeId(d.Get("test_script_type").(string))
-		monitor_id := getMonitorId(d.Get("monitor").(string))
+		monitor_id := getMonitorId(m.(*Config), d.Get("monitor").(string))
 		setRequestData(int(test_type), d.Get("test_script").(string), monitor_id, test_script_type_id, &testConfig)
 
 		testConfigUpdate := TestConfigUpdate{
@@ -1274,7 +1317,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 			advanced_setting_list := advanced_settings.(*schema.Set).List()
 			advanced_setting := advanced_setting_list[0].(map[string]interface{})
 
-			setAdvancedSettings(int(test_type), advanced_setting, &testConfig)
+			setAdvancedSettings(m.(*Config), int(test_type), advanced_setting, &testConfig)
 
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedAdvancedSettingsSection: setTestAdvancedSettings(&testConfig),
@@ -1292,7 +1335,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 
 			err := setRequestSettings(int(test_type), request_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 			testConfigUpdate := TestConfigUpdate{
 				UpdatedRequestSettingsSection: setTestRequestSettings(&testConfig),
@@ -1324,9 +1367,9 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 			schedule_setting_list := schedule_settings.(*schema.Set).List()
 			schedule_setting := schedule_setting_list[0].(map[string]interface{})
 
-			err := setScheduleSettings(int(test_type), schedule_setting, &testConfig)
+			err := setScheduleSettings(m.(*Config), int(test_type), schedule_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -1343,9 +1386,9 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 			alert_setting_list := alert_settings.(*schema.Set).List()
 			alert_setting := alert_setting_list[0].(map[string]interface{})
 
-			err := setAlertSettings(int(test_type), alert_setting, &testConfig)
+			err := setAlertSettings(m.(*Config), int(test_type), alert_setting, &testConfig)
 			if err != nil {
-				return err
+				return nil, err
 			}
 
 			testConfigUpdate := TestConfigUpdate{
@@ -1356,29 +1399,7 @@ func resourcePlaywrightTestUpdate(d *schema.ResourceData, m interface{}) error {
 		}
 	}
 
-	jsonPatchDoc := "[" + strings.Join(jsonPatchDocs, ",") + "]"
-
-	if jsonPatchDoc != "[]" {
-		log.Printf("[DEBUG] Updating test: %v", testId)
-		if m.(*Config).LogJson {
-			log.Printf("[DEBUG] Updating test with JSON PATCH: %v", jsonPatchDoc)
-		}
-		respBody, respStatus, completed, err := updateTest(api_token, testId, jsonPatchDoc)
-		if err != nil {
-			log.Fatal(err)
-		}
-		if !completed {
-			log.Printf("[ERROR] Error while Updating test: %v", testId)
-			log.Printf("[ERROR] Error description: " + respBody)
-			return errors.New(respBody)
-		}
-		log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
-		log.Print(respBody)
-
-		return resourcePlaywrightTestRead(d, m)
-	} else {
-		return errors.New("no changes. Your infrastructure matches the configuration")
-	}
+	return jsonPatchDocs, nil
 }
 
 func resourcePlaywrightTestDelete(d *schema.ResourceData, m interface{}) error {
@@ -0,0 +1,27 @@
+package catchpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var popTest = mailTestType{
+	testType:          TestType(Pop),
+	monitor:           "pop",
+	scheme:            "pop3",
+	defaultPort:       110,
+	retrievesMessages: true,
+}
+
+func resourcePopTestType() *schema.Resource {
+	return resourceMailTestType(popTest)
+}
+
+// buildPopTestConfig builds the configuration of a new pop test from the resource data
+func buildPopTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
+	return buildMailTestConfig(popTest, d, m)
+}
+
+// buildPopTestJsonPatchDocs builds the JSON Patch operations for the attributes of a pop test that changed
+func buildPopTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
+	return buildMailTestJsonPatchDocs(popTest, d, m)
+}
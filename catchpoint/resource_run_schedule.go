@@ -0,0 +1,259 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceRunSchedule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceRunScheduleCreate,
+		Read:   resourceRunScheduleRead,
+		Update: resourceRunScheduleUpdate,
+		Delete: resourceRunScheduleDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the run schedule will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the run schedule",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The run schedule description",
+			},
+			"time_zone": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The time zone the time ranges are evaluated in. Example: UTC or America/New_York",
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "include",
+				Description:  "Optional. 'include' runs tests only within the time ranges, 'exclude' skips runs within them. Defaults to 'include'",
+				ValidateFunc: validation.StringInSlice([]string{"include", "exclude"}, false),
+			},
+			"time_range": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Time ranges of the run schedule, each applying to one or more days of the week",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"days_of_week": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Days the time range applies to: 'sunday', 'monday', 'tuesday', 'wednesday', 'thursday', 'friday', 'saturday'",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{"sunday", "monday", "tuesday", "wednesday", "thursday", "friday", "saturday"}, false),
+							},
+						},
+						"start_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "Start of the time range in 24 hour HH:MM format. Example: 09:00",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format"),
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "End of the time range in 24 hour HH:MM format. Example: 17:30. A value earlier than start_time ends the range on the following day",
+							ValidateFunc: validation.StringMatch(regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9]$`), "must be a time of day in HH:MM format"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceRunScheduleCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	schedule, err := setRunSchedule(d)
+	if err != nil {
+		return err
+	}
+	scheduleJson, _ := json.Marshal(schedule)
+	jsonStr := string(scheduleJson)
+
+	if m.(*Config).LogJson {
+		log.Printf("[RUN SCHEDULE JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating run schedule: " + name)
+	respBody, respStatus, scheduleId, err := createRunSchedule(api_token, jsonStr)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating run schedule: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(scheduleId)
+	return resourceRunScheduleRead(d, m)
+}
+
+func resourceRunScheduleRead(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching run schedule: %v", scheduleId)
+
+	schedule, respStatus, err := getRunSchedule(api_token, scheduleId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading run schedule: %v", scheduleId)
+		return errors.New(respStatus)
+	}
+	if schedule == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Run schedule not found %v", scheduleId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", schedule.DivisionId)
+	d.Set("name", schedule.Name)
+	d.Set("description", schedule.Description)
+	d.Set("time_zone", schedule.TimeZone)
+	d.Set("schedule_type", getRunScheduleTypeName(schedule.ScheduleType.Id))
+	d.Set("time_range", flattenRunScheduleTimeRanges(schedule.TimeRanges))
+
+	return nil
+}
+
+func resourceRunScheduleUpdate(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	schedule, err := setRunSchedule(d)
+	if err != nil {
+		return err
+	}
+	scheduleJson, _ := json.Marshal(schedule)
+	jsonStr := string(scheduleJson)
+
+	log.Printf("[DEBUG] Updating run schedule: %v", scheduleId)
+	if m.(*Config).LogJson {
+		log.Printf("[DEBUG] Updating run schedule with JSON: %v", jsonStr)
+	}
+	respBody, respStatus, completed, err := updateRunSchedule(api_token, scheduleId, jsonStr)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating run schedule: %v", scheduleId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceRunScheduleRead(d, m)
+}
+
+func resourceRunScheduleDelete(d *schema.ResourceData, m interface{}) error {
+	scheduleId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting run schedule: %v", scheduleId)
+	respBody, respStatus, completed, err := deleteRunSchedule(api_token, scheduleId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting run schedule: %v", scheduleId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setRunSchedule(d *schema.ResourceData) (*RunSchedule, error) {
+	schedule_type := d.Get("schedule_type").(string)
+	schedule_type_id, schedule_type_name := getRunScheduleTypeId(schedule_type)
+	if schedule_type_id == -1 {
+		return nil, errors.New("invalid run schedule type string provided. acceptable values are include and exclude")
+	}
+
+	var timeRanges []RunScheduleTimeRange
+	for _, tftime_range := range d.Get("time_range").([]interface{}) {
+		time_range := tftime_range.(map[string]interface{})
+
+		var daysOfWeek []GenericIdName
+		for _, tfday := range time_range["days_of_week"].([]interface{}) {
+			day_id, day_name := getDayOfWeekId(tfday.(string))
+			if day_id == -1 {
+				return nil, errors.New("invalid day of week string provided. acceptable values are sunday, monday, tuesday, wednesday, thursday, friday and saturday")
+			}
+			daysOfWeek = append(daysOfWeek, GenericIdName{Id: day_id, Name: day_name})
+		}
+		if len(daysOfWeek) == 0 {
+			return nil, errors.New("must specify at least 1 day in days_of_week for each time_range")
+		}
+
+		start_time := time_range["start_time"].(string)
+		end_time := time_range["end_time"].(string)
+		// An end_time earlier than start_time wraps past midnight, e.g. 22:00-06:00
+		if start_time == end_time {
+			return nil, errors.New("time_range start_time and end_time must differ")
+		}
+		timeRanges = append(timeRanges, RunScheduleTimeRange{DaysOfWeek: daysOfWeek, StartTime: start_time, EndTime: end_time})
+	}
+
+	schedule := RunSchedule{
+		Id:           0,
+		DivisionId:   d.Get("division_id").(int),
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		ScheduleType: GenericIdName{Id: schedule_type_id, Name: schedule_type_name},
+		TimeZone:     d.Get("time_zone").(string),
+		TimeRanges:   timeRanges,
+	}
+
+	return &schedule, nil
+}
+
+func flattenRunScheduleTimeRanges(timeRanges []RunScheduleTimeRange) []interface{} {
+	timeRangeMaps := make([]interface{}, len(timeRanges))
+	for i, timeRange := range timeRanges {
+		daysOfWeek := make([]string, len(timeRange.DaysOfWeek))
+		for j, day := range timeRange.DaysOfWeek {
+			daysOfWeek[j] = getDayOfWeekName(day.Id)
+		}
+		timeRangeMaps[i] = map[string]interface{}{
+			"days_of_week": daysOfWeek,
+			"start_time":   timeRange.StartTime,
+			"end_time":     timeRange.EndTime,
+		}
+	}
+	return timeRangeMaps
+}
@@ -0,0 +1,26 @@
+package catchpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var smtpTest = mailTestType{
+	testType:    TestType(Smtp),
+	monitor:     "smtp",
+	scheme:      "smtp",
+	defaultPort: 25,
+}
+
+func resourceSmtpTestType() *schema.Resource {
+	return resourceMailTestType(smtpTest)
+}
+
+// buildSmtpTestConfig builds the configuration of a new smtp test from the resource data
+func buildSmtpTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
+	return buildMailTestConfig(smtpTest, d, m)
+}
+
+// buildSmtpTestJsonPatchDocs builds the JSON Patch operations for the attributes of a smtp test that changed
+func buildSmtpTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
+	return buildMailTestJsonPatchDocs(smtpTest, d, m)
+}
@@ -0,0 +1,28 @@
+package catchpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var tcpTest = portTestType{
+	testType:           TestType(Tcp),
+	protocol:           "tcp",
+	monitors:           []string{"tcp"},
+	hostExample:        "db.domain.com",
+	payloadDescription: "Optional. The data to send once the connection is established",
+	additionalMonitors: []string{"ping icmp", "ping tcp", "ping udp", "traceroute icmp", "traceroute udp", "traceroute tcp"},
+}
+
+func resourceTcpTestType() *schema.Resource {
+	return resourcePortTestType(tcpTest)
+}
+
+// buildTcpTestConfig builds the configuration of a new tcp test from the resource data
+func buildTcpTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
+	return buildPortTestConfig(tcpTest, d, m)
+}
+
+// buildTcpTestJsonPatchDocs builds the JSON Patch operations for the attributes of a tcp test that changed
+func buildTcpTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
+	return buildPortTestJsonPatchDocs(tcpTest, d, m)
+}
@@ -0,0 +1,204 @@
+package catchpoint
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTestDataWebhook() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTestDataWebhookCreate,
+		Read:   resourceTestDataWebhookRead,
+		Update: resourceTestDataWebhookUpdate,
+		Delete: resourceTestDataWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division whose tests will stream data to the webhook",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the test data webhook",
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Destination URL that receives the test data",
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+			"payload_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. Template of the payload sent to the webhook, using Catchpoint macros such as ${TestId}. Catchpoint's default JSON payload is sent if not set",
+			},
+			"header": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Optional. HTTP headers sent with every webhook request",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Header name",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "Header value. Marked sensitive since headers usually carry credentials",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				Description:  "Optional. Webhook status: active or inactive. Defaults to active",
+				ValidateFunc: validation.StringInSlice([]string{"active", "inactive"}, false),
+			},
+		},
+	}
+}
+
+func resourceTestDataWebhookCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	webhook := setTestDataWebhook(d)
+	webhookJson := marshalSecretPayload(webhook)
+
+	log.Printf("[DEBUG] Creating test data webhook: " + name)
+	respBody, respStatus, webhookId, err := createTestDataWebhook(api_token, webhookJson)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating test data webhook: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(webhookId)
+	return resourceTestDataWebhookRead(d, m)
+}
+
+func resourceTestDataWebhookRead(d *schema.ResourceData, m interface{}) error {
+	webhookId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching test data webhook: %v", webhookId)
+
+	webhook, respStatus, err := getTestDataWebhook(api_token, webhookId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading test data webhook: %v", webhookId)
+		return errors.New(respStatus)
+	}
+	if webhook == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Test data webhook not found %v", webhookId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", webhook.DivisionId)
+	d.Set("name", webhook.Name)
+	d.Set("url", webhook.Url)
+	d.Set("payload_template", webhook.Template)
+	d.Set("header", flattenTestDataWebhookHeaders(webhook.Headers))
+	d.Set("status", strings.ToLower(webhook.Status.Name))
+
+	return nil
+}
+
+func resourceTestDataWebhookUpdate(d *schema.ResourceData, m interface{}) error {
+	webhookId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	webhook := setTestDataWebhook(d)
+	webhookJson := marshalSecretPayload(webhook)
+
+	log.Printf("[DEBUG] Updating test data webhook: %v", webhookId)
+	respBody, respStatus, completed, err := updateTestDataWebhook(api_token, webhookId, webhookJson)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating test data webhook: %v", webhookId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceTestDataWebhookRead(d, m)
+}
+
+func resourceTestDataWebhookDelete(d *schema.ResourceData, m interface{}) error {
+	webhookId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting test data webhook: %v", webhookId)
+	respBody, respStatus, completed, err := deleteTestDataWebhook(api_token, webhookId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting test data webhook: %v", webhookId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setTestDataWebhook(d *schema.ResourceData) *TestDataWebhook {
+	status := d.Get("status").(string)
+
+	headers := []TestDataWebhookHeader{}
+	for _, tfheader := range d.Get("header").([]interface{}) {
+		header := tfheader.(map[string]interface{})
+		headers = append(headers, TestDataWebhookHeader{Name: header["name"].(string), Value: header["value"].(string)})
+	}
+
+	return &TestDataWebhook{
+		Id:         0,
+		DivisionId: d.Get("division_id").(int),
+		Name:       d.Get("name").(string),
+		Url:        d.Get("url").(string),
+		Template:   d.Get("payload_template").(string),
+		Headers:    headers,
+		Status:     GenericIdName{Id: getTestStatusTypeId(status), Name: status},
+	}
+}
+
+func flattenTestDataWebhookHeaders(headers []TestDataWebhookHeader) []interface{} {
+	headerMaps := make([]interface{}, len(headers))
+	for i, header := range headers {
+		headerMaps[i] = map[string]interface{}{
+			"name":  header.Name,
+			"value": header.Value,
+		}
+	}
+	return headerMaps
+}
@@ -0,0 +1,221 @@
+package catchpoint
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceTracepoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTracepointCreate,
+		Read:   resourceTracepointRead,
+		Update: resourceTracepointUpdate,
+		Delete: resourceTracepointDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"division_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "The Division where the tracepoint will be created",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the tracepoint",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "",
+				Description: "Optional. The tracepoint description",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Where the value is captured from: 'request header', 'response header', 'cookie', 'response body', 'url'",
+				ValidateFunc: validation.StringInSlice([]string{"request header", "response header", "cookie", "response body", "url"}, false),
+			},
+			"source_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Optional. Name of the header or cookie to capture. Required if source is 'request header', 'response header' or 'cookie'",
+			},
+			"expression": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Regular expression applied to the source. The first capture group is stored as the tracepoint value",
+			},
+			"data_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "string",
+				Description:  "Optional. Data type of the captured value: 'string', 'integer', 'decimal'. Defaults to 'string'",
+				ValidateFunc: validation.StringInSlice([]string{"string", "integer", "decimal"}, false),
+			},
+		},
+	}
+}
+
+func resourceTracepointCreate(d *schema.ResourceData, m interface{}) error {
+	api_token := m.(*Config).ApiToken
+	name := d.Get("name").(string)
+
+	tracepoint, err := setTracepoint(d)
+	if err != nil {
+		return err
+	}
+	tracepointJson, _ := json.Marshal(tracepoint)
+	jsonStr := string(tracepointJson)
+
+	if m.(*Config).LogJson {
+		log.Printf("[TRACEPOINT JSON] \n" + jsonStr)
+	}
+
+	log.Printf("[DEBUG] Creating tracepoint: " + name)
+	respBody, respStatus, tracepointId, err := createTracepoint(api_token, jsonStr)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while creating tracepoint: " + name)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respStatus)
+	}
+
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	d.SetId(tracepointId)
+	return resourceTracepointRead(d, m)
+}
+
+func resourceTracepointRead(d *schema.ResourceData, m interface{}) error {
+	tracepointId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Fetching tracepoint: %v", tracepointId)
+
+	tracepoint, respStatus, err := getTracepoint(api_token, tracepointId)
+	if err != nil {
+		return err
+	}
+	if respStatus != "200 ok" {
+		log.Printf("[ERROR] Error while reading tracepoint: %v", tracepointId)
+		return errors.New(respStatus)
+	}
+	if tracepoint == nil {
+		d.SetId("")
+		log.Printf("[DEBUG] Tracepoint not found %v", tracepointId)
+		return nil
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+
+	d.Set("division_id", tracepoint.DivisionId)
+	d.Set("name", tracepoint.Name)
+	d.Set("description", tracepoint.Description)
+	d.Set("source", getCaptureSourceName(tracepoint.Capture.Source.Id))
+	d.Set("source_name", tracepoint.Capture.SourceName)
+	d.Set("expression", tracepoint.Capture.Expression)
+	d.Set("data_type", getInsightDataTypeName(tracepoint.DataType.Id))
+
+	return nil
+}
+
+func resourceTracepointUpdate(d *schema.ResourceData, m interface{}) error {
+	tracepointId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	tracepoint, err := setTracepoint(d)
+	if err != nil {
+		return err
+	}
+	tracepointJson, _ := json.Marshal(tracepoint)
+	jsonStr := string(tracepointJson)
+
+	log.Printf("[DEBUG] Updating tracepoint: %v", tracepointId)
+	if m.(*Config).LogJson {
+		log.Printf("[DEBUG] Updating tracepoint with JSON: %v", jsonStr)
+	}
+	respBody, respStatus, completed, err := updateTracepoint(api_token, tracepointId, jsonStr)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while updating tracepoint: %v", tracepointId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return resourceTracepointRead(d, m)
+}
+
+func resourceTracepointDelete(d *schema.ResourceData, m interface{}) error {
+	tracepointId := d.Id()
+	api_token := m.(*Config).ApiToken
+
+	log.Printf("[DEBUG] Deleting tracepoint: %v", tracepointId)
+	respBody, respStatus, completed, err := deleteTracepoint(api_token, tracepointId)
+	if err != nil {
+		return err
+	}
+	if !completed {
+		log.Printf("[ERROR] Error while deleting tracepoint: %v", tracepointId)
+		log.Printf("[ERROR] Error description: " + respBody)
+		return errors.New(respBody)
+	}
+	log.Printf("[DEBUG] Response Code from Catchpoint API: " + respStatus)
+	log.Print(respBody)
+
+	return nil
+}
+
+func setTracepoint(d *schema.ResourceData) (*Tracepoint, error) {
+	capture, err := setInsightCapture(d)
+	if err != nil {
+		return nil, err
+	}
+	data_type_id, data_type_name := getInsightDataTypeId(d.Get("data_type").(string))
+	if data_type_id == -1 {
+		return nil, errors.New("invalid data type string provided. acceptable values are string, integer and decimal")
+	}
+
+	tracepoint := Tracepoint{
+		Id:          0,
+		DivisionId:  d.Get("division_id").(int),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Capture:     capture,
+		DataType:    GenericIdName{Id: data_type_id, Name: data_type_name},
+	}
+
+	return &tracepoint, nil
+}
+
+func setInsightCapture(d *schema.ResourceData) (InsightCapture, error) {
+	source := d.Get("source").(string)
+	source_id, source_name := getCaptureSourceId(source)
+	if source_id == -1 {
+		return InsightCapture{}, errors.New("invalid source string provided. acceptable values are request header, response header, cookie, response body and url")
+	}
+	header_or_cookie_name := d.Get("source_name").(string)
+	if header_or_cookie_name == "" && (source == "request header" || source == "response header" || source == "cookie") {
+		return InsightCapture{}, errors.New("source_name is required when source is request header, response header or cookie")
+	}
+
+	capture := InsightCapture{
+		Source:     GenericIdName{Id: source_id, Name: source_name},
+		SourceName: header_or_cookie_name,
+		Expression: d.Get("expression").(string),
+	}
+
+	return capture, nil
+}
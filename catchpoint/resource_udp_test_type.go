@@ -0,0 +1,28 @@
+package catchpoint
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var udpTest = portTestType{
+	testType:           TestType(Udp),
+	protocol:           "udp",
+	monitors:           []string{"udp"},
+	hostExample:        "syslog.domain.com",
+	payloadDescription: "Optional. The data to send in the datagram. Servers that only answer known requests need it",
+	additionalMonitors: []string{"ping icmp", "ping tcp", "ping udp", "traceroute icmp", "traceroute udp", "traceroute tcp"},
+}
+
+func resourceUdpTestType() *schema.Resource {
+	return resourcePortTestType(udpTest)
+}
+
+// buildUdpTestConfig builds the configuration of a new udp test from the resource data
+func buildUdpTestConfig(d *schema.ResourceData, m interface{}) (TestConfig, error) {
+	return buildPortTestConfig(udpTest, d, m)
+}
+
+// buildUdpTestJsonPatchDocs builds the JSON Patch operations for the attributes of a udp test that changed
+func buildUdpTestJsonPatchDocs(d *schema.ResourceData, m interface{}) ([]string, error) {
+	return buildPortTestJsonPatchDocs(udpTest, d, m)
+}
@@ -0,0 +1,62 @@
+package catchpoint
+
+import (
+	"encoding/json"
+)
+
+type RunScheduleTimeRange struct {
+	DaysOfWeek []GenericIdName `json:"daysOfWeek"`
+	StartTime  string          `json:"startTime"`
+	EndTime    string          `json:"endTime"`
+}
+
+type RunSchedule struct {
+	Id           int                    `json:"id"`
+	DivisionId   int                    `json:"divisionId"`
+	Name         string                 `json:"name"`
+	Description  string                 `json:"description"`
+	ScheduleType GenericIdName          `json:"scheduleType"`
+	TimeZone     string                 `json:"timeZone"`
+	TimeRanges   []RunScheduleTimeRange `json:"timeRanges"`
+}
+
+func getRunSchedule(apiToken string, scheduleId string) (*RunSchedule, string, error) {
+
+	type Data struct {
+		RunSchedules []RunSchedule `json:"runSchedules"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/runschedules/" + scheduleId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &RunSchedule{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Run schedule not found
+	if !response.Completed || len(response.ResponseData.RunSchedules) == 0 {
+		return nil, responseStatus, nil
+	}
+	schedule := response.ResponseData.RunSchedules[0]
+
+	return &schedule, responseStatus, nil
+}
+
+func createRunSchedule(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/runschedules", jsonPayload)
+}
+
+func updateRunSchedule(apiToken string, scheduleId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/runschedules/"+scheduleId, jsonPayload)
+}
+
+func deleteRunSchedule(apiToken string, scheduleId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/runschedules/"+scheduleId)
+}
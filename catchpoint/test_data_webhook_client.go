@@ -0,0 +1,62 @@
+package catchpoint
+
+import (
+	"encoding/json"
+)
+
+type TestDataWebhookHeader struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// An empty Template makes Catchpoint send its default JSON payload
+type TestDataWebhook struct {
+	Id         int                     `json:"id"`
+	DivisionId int                     `json:"divisionId"`
+	Name       string                  `json:"name"`
+	Url        string                  `json:"url"`
+	Template   string                  `json:"template"`
+	Headers    []TestDataWebhookHeader `json:"headers"`
+	Status     GenericIdName           `json:"status"`
+}
+
+func getTestDataWebhook(apiToken string, webhookId string) (*TestDataWebhook, string, error) {
+
+	type Data struct {
+		TestDataWebhooks []TestDataWebhook `json:"testDataWebhooks"`
+	}
+	type Response struct {
+		ResponseData Data       `json:"data"`
+		Messages     []string   `json:"messages"`
+		Errors       []ApiError `json:"errors"`
+		Completed    bool       `json:"completed"`
+		TraceId      string     `json:"traceId"`
+	}
+
+	var response Response
+	getURL := catchpointApiURI + "/testdatawebhooks/" + webhookId
+	body, responseStatus, err := sendRequest(apiToken, "GET", getURL, nil)
+	if err != nil {
+		return &TestDataWebhook{}, responseStatus, err
+	}
+	json.Unmarshal(body, &response)
+	//Test data webhook not found
+	if !response.Completed || len(response.ResponseData.TestDataWebhooks) == 0 {
+		return nil, responseStatus, nil
+	}
+	webhook := response.ResponseData.TestDataWebhooks[0]
+
+	return &webhook, responseStatus, nil
+}
+
+func createTestDataWebhook(apiToken string, jsonPayload string) (string, string, string, error) {
+	return createEntity(apiToken, catchpointApiURI+"/testdatawebhooks", jsonPayload)
+}
+
+func updateTestDataWebhook(apiToken string, webhookId string, jsonPayload string) (string, string, bool, error) {
+	return updateEntity(apiToken, catchpointApiURI+"/testdatawebhooks/"+webhookId, jsonPayload)
+}
+
+func deleteTestDataWebhook(apiToken string, webhookId string) (string, string, bool, error) {
+	return deleteEntity(apiToken, catchpointApiURI+"/testdatawebhooks/"+webhookId)
+}
@@ -1,6 +1,7 @@
 package catchpoint
 
 import (
+	"encoding/json"
 	"errors"
 	"log"
 
@@ -17,6 +18,67 @@ func setRequestData(testTypeId int, testScript string, monitorId int, testScript
 
 }
 
+func setWebSocketRequestData(testTypeId int, sub_protocols []interface{}, messages []interface{}, monitorId int, testConfig *TestConfig) error {
+	script := WebSocketScript{Messages: []WebSocketMessage{}}
+	for _, sub_protocol := range sub_protocols {
+		script.SubProtocols = append(script.SubProtocols, sub_protocol.(string))
+	}
+	for _, message := range messages {
+		message_map, ok := message.(map[string]interface{})
+		if !ok || (message_map["send"].(string) == "" && message_map["expect"].(string) == "") {
+			return errors.New("each message must set send, expect or both")
+		}
+		script.Messages = append(script.Messages, WebSocketMessage{Send: message_map["send"].(string), Expect: message_map["expect"].(string)})
+	}
+	scriptJson, _ := json.Marshal(script)
+	test_script_type_id := getApiScriptTypeId("websocket")
+	setRequestData(testTypeId, string(scriptJson), monitorId, test_script_type_id, testConfig)
+
+	return nil
+}
+
+func setCustomRequestData(testTypeId int, definition string, metrics []interface{}, monitorId int, testConfig *TestConfig) error {
+	script := CustomScript{Definition: definition, Metrics: []CustomMetric{}}
+	metric_names := map[string]bool{}
+	for _, metric := range metrics {
+		metric_map, ok := metric.(map[string]interface{})
+		if !ok {
+			return errors.New("each metric must set a name")
+		}
+		metric_name := metric_map["name"].(string)
+		if metric_names[metric_name] {
+			return errors.New("metric names must be unique, " + metric_name + " is set more than once")
+		}
+		metric_names[metric_name] = true
+		script.Metrics = append(script.Metrics, CustomMetric{Name: metric_name, DataType: metric_map["data_type"].(string), Unit: metric_map["unit"].(string), Required: metric_map["required"].(bool)})
+	}
+	scriptJson, _ := json.Marshal(script)
+	test_script_type_id := getApiScriptTypeId("custom")
+	setRequestData(testTypeId, string(scriptJson), monitorId, test_script_type_id, testConfig)
+
+	return nil
+}
+
+func setBgpSettings(testTypeId int, prefixes []string, origin_asns []interface{}, upstream_neighbors []interface{}, testConfig *TestConfig) {
+	testConfig.BgpPrefixes = prefixes
+	for _, origin_asn := range origin_asns {
+		testConfig.BgpOriginAsns = append(testConfig.BgpOriginAsns, origin_asn.(int))
+	}
+	for _, upstream_neighbor := range upstream_neighbors {
+		testConfig.BgpUpstreamNeighbors = append(testConfig.BgpUpstreamNeighbors, upstream_neighbor.(int))
+	}
+}
+
+// setBgpAlertExpressions fills in the expression of the ASN alert rules that set none from the prefixes, origin ASNs
+// and upstream neighbors set by setBgpSettings
+func setBgpAlertExpressions(testConfig *TestConfig) {
+	for i, alertRuleConfig := range testConfig.AlertRuleConfigs {
+		if alertRuleConfig.Expression == "" {
+			testConfig.AlertRuleConfigs[i].Expression = getBgpAlertExpression(alertRuleConfig.AlertSubType.Name, testConfig.BgpPrefixes, testConfig.BgpOriginAsns, testConfig.BgpUpstreamNeighbors)
+		}
+	}
+}
+
 func setLabels(testTypeId int, labels []interface{}, testConfig *TestConfig) {
 
 	for i := range labels {
@@ -43,14 +105,14 @@ func setThresholds(testTypeId int, threshold map[string]interface{}, testConfig
 	testConfig.AvailabilityThresholdCritical = availability_critical
 }
 
-func setAdvancedSettings(testTypeId int, advanced_setting map[string]interface{}, testConfig *TestConfig) {
+func setAdvancedSettings(config *Config, testTypeId int, advanced_setting map[string]interface{}, testConfig *TestConfig) {
 	var applied_test_flag_ids []int
 
 	test_flags := [28]string{"verify_test_on_failure", "debug_primary_host_on_failure", "enable_http2", "debug_referenced_hosts_on_failure", "capture_http_headers", "capture_response_content", "ignore_ssl_failures", "host_data_collection_enabled", "zone_data_collection_enabled", "f40x_or_50x_http_mark_successful", "t30x_redirects_do_not_follow", "enable_self_versus_third_party_zones", "allow_test_download_limit_override", "capture_filmstrip", "capture_screenshot", "stop_test_on_document_complete", "disable_cross_origin_iframe_access", "stop_test_on_dom_content_load", "enable_path_mtu_discovery", "protocol", "disable_recursive_resolution", "enable_bind_hostname", "enable_tcp_protocol", "enable_nsid", "enable_dnssec", "favor_fastest_round_trip_nameserver", "try_next_nameserver_on_failure", "certificate_revocation_disabled"}
 	applied_test_flag_ids = make([]int, len(test_flags))
 	for i, test_flag := range test_flags {
 		if advanced_setting[test_flag] != nil && advanced_setting[test_flag].(bool) {
-			applied_test_flag_ids[i] = getTestFlagId(test_flag)
+			applied_test_flag_ids[i] = getTestFlagId(config, test_flag)
 		}
 	}
 
@@ -83,6 +145,16 @@ func setAdvancedSettings(testTypeId int, advanced_setting map[string]interface{}
 	}
 
 	if testTypeId == int(TestType(Ssl)) ||
+		testTypeId == int(TestType(Smtp)) ||
+		testTypeId == int(TestType(Ftp)) ||
+		testTypeId == int(TestType(Tcp)) ||
+		testTypeId == int(TestType(Udp)) ||
+		testTypeId == int(TestType(Ntp)) ||
+		testTypeId == int(TestType(WebSocket)) ||
+		testTypeId == int(TestType(Mqtt)) ||
+		testTypeId == int(TestType(Imap)) ||
+		testTypeId == int(TestType(Pop)) ||
+		testTypeId == int(TestType(Streaming)) ||
 		testTypeId == int(TestType(Dns)) ||
 		testTypeId == int(TestType(Web)) ||
 		testTypeId == int(TestType(Api)) ||
@@ -104,6 +176,15 @@ func setAdvancedSettings(testTypeId int, advanced_setting map[string]interface{}
 		testConfig.TraceroutePingCount = ping_count
 	}
 
+	if testTypeId == int(TestType(Streaming)) {
+		bitrate := advanced_setting["bitrate"].(int)
+		playback_duration := advanced_setting["playback_duration"].(int)
+		startup_time_threshold := advanced_setting["startup_time_threshold"].(int)
+		testConfig.StreamingBitrate = bitrate
+		testConfig.StreamingPlaybackDuration = playback_duration
+		testConfig.StreamingStartupTimeThreshold = startup_time_threshold
+	}
+
 	testConfig.AdvancedSettingType = 1
 	testConfig.AppliedTestFlags = applied_test_flag_ids
 
@@ -129,12 +210,14 @@ func setRequestSettings(testTypeId int, request_setting map[string]interface{},
 		testConfig.AuthenticationPasswordIds = password_ids
 	}
 
-	tftoken_ids := request_setting["token_ids"].([]interface{})
-	token_ids := make([]int, len(tftoken_ids))
-	for i, token_id := range tftoken_ids {
-		token_ids[i] = token_id.(int)
+	if request_setting["token_ids"] != nil {
+		tftoken_ids := request_setting["token_ids"].([]interface{})
+		token_ids := make([]int, len(tftoken_ids))
+		for i, token_id := range tftoken_ids {
+			token_ids[i] = token_id.(int)
+		}
+		testConfig.AuthenticationTokenIds = token_ids
 	}
-	testConfig.AuthenticationTokenIds = token_ids
 
 	if request_setting["library_certificate_ids"] != nil {
 		tfcertificate_ids := request_setting["library_certificate_ids"].([]interface{})
@@ -145,7 +228,11 @@ func setRequestSettings(testTypeId int, request_setting map[string]interface{},
 		testConfig.AuthenticationCertificateIds = certificate_ids
 	}
 
-	http_request_headers_list := request_setting["http_request_headers"].(*schema.Set).List()
+	// Tests of protocols other than HTTP have no request headers to override
+	var http_request_headers_list []interface{}
+	if request_setting["http_request_headers"] != nil {
+		http_request_headers_list = request_setting["http_request_headers"].(*schema.Set).List()
+	}
 	for i := range http_request_headers_list {
 		http_request_headers := http_request_headers_list[i].(map[string]interface{})
 		request_headers := [14]string{"user_agent", "accept", "accept_encoding", "accept_language", "accept_charset", "cookie", "cache_control", "pragma", "referer", "host", "request_override", "dns_override", "request_block", "request_delay"}
@@ -186,9 +273,9 @@ func setInsightSettings(testTypeId int, insight_setting map[string]interface{},
 	testConfig.IndicatorIds = indicator_ids
 }
 
-func setScheduleSettings(testTypeId int, schedule_setting map[string]interface{}, testConfig *TestConfig) error {
+func setScheduleSettings(config *Config, testTypeId int, schedule_setting map[string]interface{}, testConfig *TestConfig) error {
 	frequency := schedule_setting["frequency"].(string)
-	frequency_id, frequency_name := getFrequencyId(frequency)
+	frequency_id, frequency_name := getFrequencyId(config, frequency)
 	if frequency_id == -1 {
 		return errors.New("invalid test scheduling frequency string provided. acceptable values are 1 minute, 2 minutes, 4 minutes, 5 minutes, 10 minutes, 15 minutes, 20 minutes, 30 minutes, 60 minutes, 2 hours, 3 hours, 4 hours, 6 hours, 8 hours, 12 hours, 24 hours")
 	}
@@ -241,7 +328,7 @@ func setScheduleSettings(testTypeId int, schedule_setting map[string]interface{}
 	return nil
 }
 
-func setAlertSettings(testTypeId int, alert_setting map[string]interface{}, testConfig *TestConfig) error {
+func setAlertSettings(config *Config, testTypeId int, alert_setting map[string]interface{}, testConfig *TestConfig) error {
 
 	alert_rule_list := alert_setting["alert_rule"].(*schema.Set).List()
 	for i := range alert_rule_list {
@@ -256,12 +343,12 @@ func setAlertSettings(testTypeId int, alert_setting map[string]interface{}, test
 		threshold_percentage_of_runs := alert_rule["threshold_percentage_of_runs"].(float64)
 		enable_consecutive := alert_rule["enable_consecutive"].(bool)
 		warning_reminder := alert_rule["warning_reminder"].(string)
-		warning_reminder_id, warning_reminder_name := getReminderId(warning_reminder)
+		warning_reminder_id, warning_reminder_name := getReminderId(config, warning_reminder)
 		if warning_reminder_id == 0 {
 			log.Printf("[INFO] warning_reminder was not set or an invalid interval string was provided and defaulted to none")
 		}
 		critical_reminder := alert_rule["critical_reminder"].(string)
-		critical_reminder_id, critical_reminder_name := getReminderId(critical_reminder)
+		critical_reminder_id, critical_reminder_name := getReminderId(config, critical_reminder)
 		if critical_reminder_id == 0 {
 			log.Printf("[INFO] critical_reminder was not set or an invalid interval string was provided and defaulted to none")
 		}
@@ -325,7 +412,7 @@ func setAlertSettings(testTypeId int, alert_setting map[string]interface{}, test
 		var alert_sub_type_id int
 		var alert_sub_type_name string
 		if alert_type_id != 9 && alert_type_id != 4 {
-			alert_sub_type_id, alert_sub_type_name = getAlertSubTypeId(alert_sub_type)
+			alert_sub_type_id, alert_sub_type_name = getAlertSubTypeId(config, alert_sub_type)
 			if alert_sub_type_id == -1 {
 				return errors.New("must specify the alert sub type. for example 'test' for alert_type 'availability'")
 			}
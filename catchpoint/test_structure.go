@@ -19,6 +19,18 @@ type TestConfig struct {
 	DnsQueryType                   IdName
 	DnsServer                      string
 	EdnsSubnet                     string
+	EncryptionType                 IdName
+	FtpOperationType               IdName
+	ExpectedFileSize               int
+	Payload                        string
+	ExpectedResponse               string
+	NtpOffsetThreshold             int
+	MqttTopic                      string
+	MqttQos                        int
+	Mailbox                        string
+	BgpPrefixes                    []string
+	BgpOriginAsns                  []int
+	BgpUpstreamNeighbors           []int
 	TestUrl                        string
 	TestDescription                string
 	GatewayAddressOrHost           string
@@ -66,6 +78,9 @@ type TestConfig struct {
 	ViewportWidth                  int
 	TracerouteFailureHopCount      int
 	TraceroutePingCount            int
+	StreamingBitrate               int
+	StreamingPlaybackDuration      int
+	StreamingStartupTimeThreshold  int
 	AlertSubject                   string
 }
 
@@ -124,5 +139,6 @@ type TestConfigUpdate struct {
 	UpdatedLabels                  []Label
 	UpdatedTestThresholds          Thresholds
 	UpdatedTestRequestData         TestRequestDataStruct
+	UpdatedBgpSettingsSection      BgpSettings
 	SectionToUpdate                string
 }
@@ -0,0 +1,44 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "catchpoint_tracepoint" "cdn_cache" {
+  provider=catchpoint
+  division_id=2633
+  name="CDN cache status"
+  source="response header"
+  source_name="X-Cache"
+  expression="(HIT|MISS)"
+}
+
+resource "catchpoint_indicator" "server_timing" {
+  provider=catchpoint
+  division_id=2633
+  name="Origin server time"
+  source="response header"
+  source_name="Server-Timing"
+  expression="origin;dur=([0-9.]+)"
+  data_type="decimal"
+}
+
+resource "web_test" "webTest" {
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  test_name="Web_TF_Insights"
+  test_url="https://www.catchpoint.com"
+  end_time="2024-10-30T04:59:00Z"
+  insights{
+      tracepoint_ids=[catchpoint_tracepoint.cdn_cache.id]
+      indicator_ids=[catchpoint_indicator.server_timing.id]
+    }
+}
@@ -0,0 +1,69 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+variable "checkout_password" {
+  type      = string
+  sensitive = true
+}
+
+variable "checkout_token" {
+  type      = string
+  sensitive = true
+}
+
+resource "catchpoint_library_password" "checkout" {
+  provider=catchpoint
+  division_id=2923
+  name="Checkout basic auth"
+  username="monitor"
+  password=var.checkout_password
+}
+
+resource "catchpoint_library_token" "checkout" {
+  provider=catchpoint
+  division_id=2923
+  name="Checkout API token"
+  value=var.checkout_token
+}
+
+resource "catchpoint_library_certificate" "checkout" {
+  provider=catchpoint
+  division_id=2923
+  name="Checkout client certificate"
+  certificate=file("certs/client.pem")
+  private_key=file("certs/client.key")
+}
+
+resource "web_test" "checkout" {
+    test_name  = "Checkout with library credentials"
+    monitor="chrome"
+    provider=catchpoint
+    division_id=2923
+    product_id=28335
+    test_url="https://www.catchpoint.com"
+
+    request_settings {
+      authentication {
+        authentication_type = "basic"
+        password_ids = [catchpoint_library_password.checkout.id]
+      }
+      token_ids = [catchpoint_library_token.checkout.id]
+      library_certificate_ids = [catchpoint_library_certificate.checkout.id]
+    }
+
+    schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      node_ids =[6388]
+    }
+}
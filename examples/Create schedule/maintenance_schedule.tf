@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "catchpoint_maintenance_schedule" "release_window" {
+  provider=catchpoint
+  division_id=2633
+  name="Weekly release window"
+  time_zone="UTC"
+  start_time="2024-05-04T22:00:00Z"
+  duration=120
+  recurrence{
+      frequency="weekly"
+      days_of_week=["saturday"]
+    }
+}
+
+resource "ping_test" "pingTest" {
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  test_name="Ping_TF_Maintenance"
+  test_location="www.google.com"
+  end_time="2024-10-30T04:59:00Z"
+  schedule_settings{
+      frequency="6 hours"
+      node_distribution="random"
+      node_ids=[6388]
+      maintenance_schedule_id=catchpoint_maintenance_schedule.release_window.id
+    }
+}
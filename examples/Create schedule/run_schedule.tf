@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "catchpoint_run_schedule" "business_hours" {
+  provider=catchpoint
+  division_id=2633
+  name="Business hours"
+  time_zone="America/New_York"
+  schedule_type="include"
+  time_range{
+      days_of_week=["monday","tuesday","wednesday","thursday","friday"]
+      start_time="09:00"
+      end_time="17:30"
+    }
+}
+
+resource "web_test" "webTest" {
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  test_name="Web_TF_Business_Hours"
+  test_url="https://www.catchpoint.com"
+  end_time="2024-10-30T04:59:00Z"
+  schedule_settings{
+      frequency="15 minutes"
+      node_distribution="random"
+      node_ids=[6388]
+      run_schedule_id=catchpoint_run_schedule.business_hours.id
+    }
+}
@@ -20,7 +20,39 @@ resource "bgp_test" "test" {
     provider=catchpoint
     division_id=2633
     product_id=23791
-    prefix="101.188.67.134/8"
+    prefixes=["101.188.67.0/24","2001:db8:1000::/36"]
+    expected_origin_asns=[64500]
+    expected_upstream_neighbors=[174,3356]
     start_time = "2024-04-30T04:59:00Z"
     end_time="2024-10-30T04:59:00Z"
+    alert_settings{
+        alert_rule{
+            node_threshold_type="node"
+            threshold_number_of_runs=1
+            alert_type="asn"
+            alert_sub_type="origin as"
+            trigger_type="specific value"
+            notification_group{
+                subject="Prefix announced by an unexpected origin"
+                notify_on_critical=true
+                recipient_email_ids=["network-oncall@example.com"]
+              }
+          }
+        alert_rule{
+            node_threshold_type="node"
+            threshold_number_of_runs=1
+            alert_type="asn"
+            alert_sub_type="origin neighbor"
+            trigger_type="specific value"
+            notification_group{
+                subject="Prefix reached through an unexpected upstream"
+                notify_on_critical=true
+                recipient_email_ids=["network-oncall@example.com"]
+              }
+          }
+        notification_group{
+            subject="BGP alerts"
+            recipient_email_ids=["network-oncall@example.com"]
+          }
+      }
 }
\ No newline at end of file
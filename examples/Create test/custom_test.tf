@@ -0,0 +1,69 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "custom_test" "customTest" {
+  test_name  = "Custom_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="custom"
+  definition="/opt/checks/ledger_reconcile.sh --region us-east"
+  metric{
+      name="ledger.lag"
+      unit="ms"
+    }
+  metric{
+      name="ledger.reconciled"
+      data_type="boolean"
+      required=true
+    }
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  label{
+      key="team"
+      values=["ledger"]
+    }
+  thresholds{
+      test_time_warning=2000
+      test_time_critical=5000
+      availability_warning=99
+      availability_critical=95
+    }
+  schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 3
+      node_ids =[6388,6389,6390]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="availability"
+          alert_sub_type="test"
+          trigger_type="specific value"
+          operation_type="less than"
+          warning_trigger=99
+          critical_trigger=95
+          notification_group{
+              subject="Ledger reconciliation failing"
+              notify_on_critical=true
+              recipient_email_ids=["ledger-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="Custom test alerts"
+          recipient_email_ids=["ledger-oncall@example.com"]
+        }
+    }
+}
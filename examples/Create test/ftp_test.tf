@@ -0,0 +1,58 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "ftp_test" "ftpTest" {
+  test_name  = "FTP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="ftp"
+  test_url="sftp://files.catchpoint.com/drop/daily_report.csv"
+  operation="download"
+  expected_file_size=204800
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  request_settings{
+      authentication{
+          password_ids=[1407]
+      }
+    }
+  schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=1
+          alert_type="byte length"
+          alert_sub_type="file size"
+          trigger_type="specific value"
+          operation_type="less than"
+          warning_trigger=204800
+          critical_trigger=102400
+          notification_group{
+              subject="Partner file drop is short"
+              notify_on_warning=true
+              notify_on_critical=true
+              recipient_email_ids=["partners-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="FTP alerts"
+          recipient_email_ids=["partners-oncall@example.com"]
+        }
+    }
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "imap_test" "imapTest" {
+  test_name  = "IMAP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="imap"
+  host="mail.catchpoint.com"
+  port=993
+  tls_mode="tls"
+  mailbox="Monitoring/Probes"
+  expected_response="Subject: Catchpoint probe"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  request_settings{
+      authentication{
+          password_ids=[1409]
+      }
+    }
+  schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="content match"
+          alert_sub_type="regular expression"
+          notification_group{
+              subject="Probe message was not retrieved"
+              notify_on_critical=true
+              recipient_email_ids=["mail-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="IMAP alerts"
+          recipient_email_ids=["mail-oncall@example.com"]
+        }
+    }
+}
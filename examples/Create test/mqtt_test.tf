@@ -0,0 +1,56 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "mqtt_test" "mqttTest" {
+  test_name  = "MQTT_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="mqtt"
+  test_url="mqtts://broker.catchpoint.com:8883"
+  topic="fleet/synthetic/heartbeat"
+  qos=1
+  payload="catchpoint-heartbeat"
+  expected_response="^catchpoint-heartbeat$"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  request_settings{
+      authentication{
+          password_ids=[1408]
+      }
+      library_certificate_ids=[312]
+    }
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="content match"
+          alert_sub_type="regular expression"
+          notification_group{
+              subject="Broker did not deliver the heartbeat"
+              notify_on_critical=true
+              recipient_email_ids=["iot-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="MQTT alerts"
+          recipient_email_ids=["iot-oncall@example.com"]
+        }
+    }
+}
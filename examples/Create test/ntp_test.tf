@@ -0,0 +1,67 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "ntp_test" "ntpTest" {
+  test_name  = "NTP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="ntp"
+  host="time.catchpoint.com"
+  offset_threshold=500
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 3
+      node_ids =[6388,6389,6390]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="timing"
+          alert_sub_type="offset"
+          trigger_type="specific value"
+          operation_type="greater than"
+          warning_trigger=100
+          critical_trigger=250
+          notification_group{
+              subject="NTP offset is drifting"
+              notify_on_warning=true
+              notify_on_critical=true
+              recipient_email_ids=["identity-oncall@example.com"]
+            }
+        }
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="availability"
+          alert_sub_type="test"
+          trigger_type="specific value"
+          operation_type="less than"
+          warning_trigger=99
+          critical_trigger=95
+          notification_group{
+              subject="NTP server unavailable"
+              notify_on_critical=true
+              recipient_email_ids=["identity-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="NTP alerts"
+          recipient_email_ids=["identity-oncall@example.com"]
+        }
+    }
+}
@@ -0,0 +1,88 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "smtp_test" "smtpTest" {
+  test_name  = "SMTP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="smtp"
+  host="smtp.catchpoint.com"
+  port=587
+  tls_mode="starttls"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  request_settings{
+      authentication{
+          authentication_type="login"
+          password_ids=[1406]
+      }
+    }
+  label{
+      key="team"
+      values=["mail platform"]
+    }
+  thresholds{
+      test_time_warning=500
+      test_time_critical=1000
+      availability_warning=95
+      availability_critical=90
+    }
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="availability"
+          alert_sub_type="test"
+          trigger_type="specific value"
+          operation_type="less than"
+          warning_trigger=95
+          critical_trigger=90
+          notification_group{
+              subject="SMTP availability"
+              notify_on_warning=true
+              notify_on_critical=true
+              recipient_email_ids=["mail-oncall@example.com"]
+            }
+        }
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="timing"
+          alert_sub_type="connect"
+          trigger_type="specific value"
+          operation_type="greater than"
+          warning_trigger=300
+          critical_trigger=600
+          notification_group{
+              subject="SMTP connect time"
+              notify_on_critical=true
+              recipient_email_ids=["mail-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="SMTP alerts"
+          recipient_email_ids=["mail-oncall@example.com"]
+        }
+    }
+  advanced_settings{
+      verify_test_on_failure=true
+      additional_monitor="traceroute tcp"
+    }
+}
@@ -0,0 +1,72 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "streaming_test" "streamingTest" {
+  test_name  = "Streaming_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="streaming"
+  test_url="https://video.catchpoint.com/live/master.m3u8"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 3
+      node_ids =[6388,6389,6390]
+    }
+  advanced_settings{
+      bitrate=2500
+      playback_duration=30
+      startup_time_threshold=4000
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="timing"
+          alert_sub_type="startup time"
+          trigger_type="specific value"
+          operation_type="greater than"
+          warning_trigger=2000
+          critical_trigger=3000
+          notification_group{
+              subject="Stream is slow to start"
+              notify_on_warning=true
+              notify_on_critical=true
+              recipient_email_ids=["video-oncall@example.com"]
+            }
+        }
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="timing"
+          alert_sub_type="buffering time"
+          trigger_type="specific value"
+          operation_type="greater than"
+          warning_trigger=1000
+          critical_trigger=5000
+          notification_group{
+              subject="Stream is buffering"
+              notify_on_warning=true
+              notify_on_critical=true
+              recipient_email_ids=["video-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="Streaming alerts"
+          recipient_email_ids=["video-oncall@example.com"]
+        }
+    }
+}
@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "tcp_test" "tcpTest" {
+  test_name  = "TCP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="tcp"
+  host="redis.catchpoint.com"
+  port=6379
+  payload="PING\r\n"
+  expected_response="^\\+PONG"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="content match"
+          alert_sub_type="regular expression"
+          notification_group{
+              subject="Redis does not answer PING"
+              notify_on_critical=true
+              recipient_email_ids=["platform-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="TCP alerts"
+          recipient_email_ids=["platform-oncall@example.com"]
+        }
+    }
+  advanced_settings{
+      verify_test_on_failure=true
+      additional_monitor="traceroute tcp"
+    }
+}
@@ -0,0 +1,40 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "udp_test" "udpTest" {
+  test_name  = "UDP_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="udp"
+  host="game.catchpoint.com"
+  port=27015
+  payload="status"
+  expected_response="players"
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  thresholds{
+      test_time_warning=100
+      test_time_critical=250
+      availability_warning=99
+      availability_critical=95
+    }
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="concurrent"
+      node_group_ids =[9922]
+    }
+  advanced_settings{
+      additional_monitor="ping udp"
+    }
+}
@@ -100,3 +100,12 @@ resource "web_test" "test" {
             }
     }
 }
+
+# Nodes and alert rules that apply to the test, including the ones inherited from its folder, product and division
+output "web_test_effective_node_ids" {
+  value = web_test.test.effective_schedule_settings[*].node_ids
+}
+
+output "web_test_effective_alert_rules" {
+  value = web_test.test.effective_alert_settings[*].alert_rule
+}
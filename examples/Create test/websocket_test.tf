@@ -0,0 +1,63 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "websocket_test" "websocketTest" {
+  test_name  = "WebSocket_TF 1"
+  provider=catchpoint
+  division_id=2633
+  product_id=23791
+  monitor="websocket"
+  test_url="wss://feed.catchpoint.com/quotes"
+  sub_protocols=["graphql-transport-ws"]
+  message{
+      send=jsonencode({type="connection_init"})
+      expect="connection_ack"
+    }
+  message{
+      send=jsonencode({id="1", type="subscribe", payload={query="subscription { quote(symbol: \"CPT\") { price } }"}})
+      expect="\"price\":\\s*[0-9.]+"
+    }
+  status="active"
+  end_time="2024-10-30T04:59:00Z"
+  request_settings{
+      token_ids=[2207]
+      http_request_headers{
+          user_agent{
+              value="catchpoint-websocket-monitor"
+            }
+        }
+    }
+  schedule_settings{
+      frequency="5 minutes"
+      node_distribution ="random"
+      no_of_subset_nodes = 2
+      node_ids =[6388,6389]
+    }
+  alert_settings{
+      alert_rule{
+          node_threshold_type="node"
+          threshold_number_of_runs=2
+          alert_type="content match"
+          alert_sub_type="regular expression"
+          notification_group{
+              subject="Quote feed stopped streaming prices"
+              notify_on_critical=true
+              recipient_email_ids=["trading-ui-oncall@example.com"]
+            }
+        }
+      notification_group{
+          subject="WebSocket alerts"
+          recipient_email_ids=["trading-ui-oncall@example.com"]
+        }
+    }
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "catchpoint_test_data_webhook" "collector" {
+  provider=catchpoint
+  division_id=2923
+  name="Observability collector"
+  url="https://collector.example.com/catchpoint"
+  payload_template=jsonencode({
+    test_id  = "$${TestId}"
+    node     = "$${NodeName}"
+    response = "$${ResponseTime}"
+  })
+
+  header {
+    name="Authorization"
+    value="Bearer XXXXXXXXXXXX"
+  }
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_alerts" "checkout_critical" {
+  provider=catchpoint
+  label_key="team"
+  label_value="checkout"
+  severity="critical"
+}
+
+check "checkout_not_critical" {
+  assert {
+    condition     = length(data.catchpoint_alerts.checkout_critical.alerts) == 0
+    error_message = "Checkout tests in a critical alert state: ${join(", ", distinct([for a in data.catchpoint_alerts.checkout_critical.alerts : a.test_name]))}"
+  }
+}
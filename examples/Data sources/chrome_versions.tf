@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_chrome_versions" "available" {
+  provider=catchpoint
+}
+
+resource "web_test" "latest_chrome" {
+    test_name  = "Homepage on the newest Chrome"
+    monitor="chrome"
+    chrome_version=data.catchpoint_chrome_versions.available.latest
+    provider=catchpoint
+    division_id=2923
+    product_id=28335
+    test_url="https://www.catchpoint.com"
+}
@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+live_enums="true"
+}
+
+data "catchpoint_enums" "monitors" {
+  provider=catchpoint
+  catalogue="monitors"
+}
+
+output "monitor_catalogue_source" {
+  value = data.catchpoint_enums.monitors.source
+}
+
+output "monitors_missing_from_provider" {
+  value = [for v in data.catchpoint_enums.monitors.values : v.name if !v.compiled]
+}
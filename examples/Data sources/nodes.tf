@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_nodes" "us_backbone" {
+  provider=catchpoint
+  countries=["United States"]
+  network_types=["backbone"]
+  ip_version="ipv4"
+  status="active"
+}
+
+resource "web_test" "homepage" {
+    test_name  = "Homepage from US backbone"
+    monitor="chrome"
+    provider=catchpoint
+    division_id=2923
+    product_id=28335
+    test_url="https://www.catchpoint.com"
+
+    schedule_settings{
+      frequency="15 minutes"
+      node_distribution ="random"
+      node_ids = data.catchpoint_nodes.us_backbone.ids
+    }
+}
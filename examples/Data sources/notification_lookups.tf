@@ -0,0 +1,53 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_contact_groups" "oncall" {
+  provider=catchpoint
+  names=["Web Oncall","Checkout Team"]
+}
+
+data "catchpoint_alert_webhooks" "pager" {
+  provider=catchpoint
+  names=["PagerDuty"]
+}
+
+resource "web_test" "homepage" {
+  provider=catchpoint
+  division_id=1000
+  product_id=19000
+  test_name="Homepage"
+  test_url="https://www.example.com"
+  alert_settings {
+    alert_rule {
+      alert_type="availability"
+      alert_sub_type="test"
+      node_threshold_type="average across nodes"
+      threshold_number_of_runs=3
+      trigger_type="specific value"
+      warning_trigger=50
+      critical_trigger=80
+      operation_type="greater than"
+      notification_group {
+        notify_on_critical=true
+        subject="Homepage availability"
+        contact_groups=data.catchpoint_contact_groups.oncall.contact_groups[*].name
+      }
+    }
+    notification_group {
+      notify_on_critical=true
+      subject="Homepage alert"
+      contact_groups=data.catchpoint_contact_groups.oncall.contact_groups[*].name
+      alert_webhook_ids=data.catchpoint_alert_webhooks.pager.ids
+    }
+  }
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_product" "platform" {
+  provider=catchpoint
+  division_id=1000
+  name="Platform"
+}
+
+data "catchpoint_folder" "checkout" {
+  provider=catchpoint
+  division_id=1000
+  product_id=data.catchpoint_product.platform.product_id
+  path="Prod/Web/Checkout"
+}
+
+resource "web_test" "checkout" {
+  provider=catchpoint
+  division_id=1000
+  product_id=data.catchpoint_product.platform.product_id
+  folder_id=data.catchpoint_folder.checkout.folder_id
+  test_name="Checkout"
+  test_url="https://www.example.com/checkout"
+}
+
+output "checkout_frequency" {
+  value = data.catchpoint_folder.checkout.schedule_settings[0].frequency
+}
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_test_payload" "homepage" {
+  provider=catchpoint
+  web_test {
+    division_id=1000
+    product_id=19000
+    test_name="Homepage"
+    test_url="https://www.example.com"
+    monitor="chrome"
+    chrome_version="stable"
+    label {
+      key="team"
+      values=["web"]
+    }
+    schedule_settings {
+      frequency="15 minutes"
+      node_distribution="random"
+      node_ids=[6388]
+    }
+  }
+}
+
+# Written for review or for policy tools such as conftest
+resource "local_file" "homepage_payload" {
+  filename = "${path.module}/payloads/homepage.json"
+  content  = data.catchpoint_test_payload.homepage.json
+}
+
+output "homepage_patch" {
+  value = jsondecode(data.catchpoint_test_payload.homepage.json_patch)
+}
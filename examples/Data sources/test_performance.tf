@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_test" "homepage" {
+  provider=catchpoint
+  test_name="Homepage"
+}
+
+data "catchpoint_test_performance" "homepage" {
+  provider=catchpoint
+  test_ids=[data.catchpoint_test.homepage.test_id]
+  lookback_minutes=60
+}
+
+check "homepage_performance" {
+  assert {
+    condition     = data.catchpoint_test_performance.homepage.test_time_p95 < 3000
+    error_message = "Homepage p95 test time over the last hour is ${data.catchpoint_test_performance.homepage.test_time_p95}ms"
+  }
+  assert {
+    condition     = data.catchpoint_test_performance.homepage.availability >= 99
+    error_message = "Homepage availability over the last hour is ${data.catchpoint_test_performance.homepage.availability}%"
+  }
+}
+
+output "slowest_nodes" {
+  value = [for n in data.catchpoint_test_performance.homepage.tests[0].nodes : n.node_name if n.test_time_p95 >= 3000]
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+
+provider "catchpoint" {
+api_token="5618ABF44CA1117B428XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+data "catchpoint_test" "checkout" {
+  provider=catchpoint
+  test_name="Checkout flow"
+  division_id=2923
+  product_id=28335
+}
+
+data "catchpoint_tests" "active_chrome" {
+  provider=catchpoint
+  division_id=2923
+  test_type="web"
+  monitor="chrome"
+  label_key="team"
+  label_value="payments"
+  status="active"
+}
+
+output "checkout_frequency" {
+  value = one(data.catchpoint_test.checkout.schedule_settings).frequency
+}
+
+output "payments_test_ids" {
+  value = data.catchpoint_tests.active_chrome.ids
+}
@@ -0,0 +1,37 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+variable "checkout_password" {
+  type      = string
+  sensitive = true
+}
+
+# Secrets are never read back from the API, so an imported password has no
+# value in state and the first apply would rewrite it. Ignoring changes keeps
+# the stored password; remove the lifecycle block to rotate it from Terraform.
+resource "catchpoint_library_password" "checkout" {
+    provider=catchpoint
+    division_id=2923
+    name="Checkout basic auth"
+    username="monitor"
+    password=var.checkout_password
+
+    lifecycle {
+      ignore_changes = [password]
+    }
+}
+
+# =========================================================
+# Command to run the importing library password details:
+# terraform import catchpoint_library_password.checkout 1187
+# The same applies to catchpoint_library_token (value) and
+# catchpoint_library_certificate (certificate, private_key, pass_phrase).
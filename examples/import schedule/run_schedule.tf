@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "catchpoint_run_schedule" "runSchedule" {
+    provider=catchpoint
+    id="4521"
+}
+
+# =========================================================
+# Command to run the importing run schedule details:
+# terraform import catchpoint_run_schedule.runSchedule 4521
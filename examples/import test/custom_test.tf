@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "custom_test" "customTest" {
+    provider=catchpoint
+    id="2340170"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import custom_test.customTest 2340170
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "ftp_test" "ftpTest" {
+    provider=catchpoint
+    id="2340161"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import ftp_test.ftpTest 2340161
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "pop_test" "popTest" {
+    provider=catchpoint
+    id="2340168"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import pop_test.popTest 2340168
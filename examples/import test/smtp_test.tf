@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "smtp_test" "smtpTest" {
+    provider=catchpoint
+    id="2340160"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import smtp_test.smtpTest 2340160
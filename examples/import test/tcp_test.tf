@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "tcp_test" "tcpTest" {
+    provider=catchpoint
+    id="2340162"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import tcp_test.tcpTest 2340162
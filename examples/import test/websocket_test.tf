@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    catchpoint = {
+      source  = "catchpoint/catchpoint"
+      version = "1.4.0"
+    }
+  }
+}
+provider "catchpoint" {
+api_token="5618ABF44CA1117B4286C9572XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "websocket_test" "websocketTest" {
+    provider=catchpoint
+    id="2340165"
+}
+
+# =========================================================
+# Command to run the importing test details:
+# terraform import websocket_test.websocketTest 2340165